@@ -0,0 +1,238 @@
+package client
+
+import (
+	"time"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// RIR represents a Netbox Regional Internet Registry, such as RIPE or ARIN.
+// Private RIRs stand for private address space, e.g. RFC 1918.
+type RIR struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Name           string         `json:"name"`
+	Slug           string         `json:"slug"`
+	IsPrivate      bool           `json:"is_private"`
+	Description    string         `json:"description,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	AggregateCount int            `json:"aggregate_count"`
+}
+
+// CreateRIRInput represents the input for creating a RIR
+type CreateRIRInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	IsPrivate    bool               `json:"is_private,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRIRInput
+func (input *CreateRIRInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRIRInput represents the input for updating a RIR
+type UpdateRIRInput CreateRIRInput
+
+// Validate validates the UpdateRIRInput
+func (input *UpdateRIRInput) Validate() error {
+	return (*CreateRIRInput)(input).Validate()
+}
+
+// PatchRIRInput represents the input for patching a RIR
+type PatchRIRInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	IsPrivate    *bool               `json:"is_private,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRIRInput
+func (input *PatchRIRInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRIRsInput represents the input for listing RIRs
+type ListRIRsInput struct {
+	Query     string   `query:"q"`          // General search
+	Name      string   `query:"name__ic"`   // Filter by name (case-insensitive partial match)
+	Slug      []string `query:"slug"`       // Filter by slug
+	IsPrivate *bool    `query:"is_private"` // Filter by private flag
+	Tag       []string `query:"tag"`        // Filter by tag slug
+	Limit     int      `query:"limit"`      // Number of results to return per page
+	Offset    int      `query:"offset"`     // The initial index from which to return the results
+}
+
+// Aggregate represents a Netbox aggregate, a top-level block of address space
+// allocated by a RIR
+type Aggregate struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Family       *AddressFamily `json:"family"`
+	Prefix       string         `json:"prefix"`
+	RIR          *RIR           `json:"rir"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	DateAdded    *string        `json:"date_added,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateAggregateInput represents the input for creating an aggregate.
+// DateAdded uses the YYYY-MM-DD format.
+type CreateAggregateInput struct {
+	Prefix       string             `json:"prefix"`
+	RIR          int                `json:"rir"`
+	Tenant       int                `json:"tenant,omitempty"`
+	DateAdded    string             `json:"date_added,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateAggregateInput
+func (input *CreateAggregateInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidatePrefix("prefix", input.Prefix); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.RIR == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rir",
+			Message: "RIR is required",
+		})
+	}
+
+	if input.DateAdded != "" {
+		if err := validateDate("date_added", input.DateAdded); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateAggregateInput represents the input for updating an aggregate
+type UpdateAggregateInput CreateAggregateInput
+
+// Validate validates the UpdateAggregateInput
+func (input *UpdateAggregateInput) Validate() error {
+	return (*CreateAggregateInput)(input).Validate()
+}
+
+// PatchAggregateInput represents the input for patching an aggregate
+type PatchAggregateInput struct {
+	Prefix       *string             `json:"prefix,omitempty"`
+	RIR          *int                `json:"rir,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	DateAdded    *string             `json:"date_added,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchAggregateInput
+func (input *PatchAggregateInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Prefix != nil {
+		if err := models.ValidatePrefix("prefix", *input.Prefix); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.DateAdded != nil && *input.DateAdded != "" {
+		if err := validateDate("date_added", *input.DateAdded); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListAggregatesInput represents the input for listing aggregates. Slice
+// fields match any of the given values.
+type ListAggregatesInput struct {
+	Query         string   `query:"q"`              // General search
+	Prefix        []string `query:"prefix"`         // Filter by exact prefix
+	Within        string   `query:"within"`         // Filter by aggregates within the given prefix
+	WithinInclude string   `query:"within_include"` // Filter by aggregates within and including the given prefix
+	Contains      string   `query:"contains"`       // Filter by aggregates containing the given prefix or address
+	Family        int      `query:"family"`         // Filter by address family (4 or 6)
+	RIRID         []int    `query:"rir_id"`         // Filter by RIR ID
+	RIR           []string `query:"rir"`            // Filter by RIR slug
+	TenantID      []int    `query:"tenant_id"`      // Filter by tenant ID
+	DateAdded     []string `query:"date_added"`     // Filter by date added (YYYY-MM-DD)
+	Tag           []string `query:"tag"`            // Filter by tag slug
+	Limit         int      `query:"limit"`          // Number of results to return per page
+	Offset        int      `query:"offset"`         // The initial index from which to return the results
+}
+
+// validateDate checks that a value is a calendar date in the YYYY-MM-DD format
+func validateDate(field, value string) error {
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		return &models.ValidationError{
+			Field:   field,
+			Message: "must be a date in the YYYY-MM-DD format",
+		}
+	}
+
+	return nil
+}
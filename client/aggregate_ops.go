@@ -0,0 +1,17 @@
+package client
+
+// RIRResource is the typed resource for ipam/rirs
+type RIRResource = Resource[RIR, CreateRIRInput, UpdateRIRInput, PatchRIRInput, ListRIRsInput]
+
+// RIRs returns the typed resource for ipam/rirs
+func (c *Client) RIRs() *RIRResource {
+	return NewResource[RIR, CreateRIRInput, UpdateRIRInput, PatchRIRInput, ListRIRsInput](c, "ipam", "rirs")
+}
+
+// AggregateResource is the typed resource for ipam/aggregates
+type AggregateResource = Resource[Aggregate, CreateAggregateInput, UpdateAggregateInput, PatchAggregateInput, ListAggregatesInput]
+
+// Aggregates returns the typed resource for ipam/aggregates
+func (c *Client) Aggregates() *AggregateResource {
+	return NewResource[Aggregate, CreateAggregateInput, UpdateAggregateInput, PatchAggregateInput, ListAggregatesInput](c, "ipam", "aggregates")
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Bounds of 4-byte autonomous system numbers
+const (
+	MinASN int64 = 1
+	MaxASN int64 = 4294967295
+)
+
+// ASN represents a Netbox autonomous system number, assigned to sites and providers
+type ASN struct {
+	ID            int            `json:"id"`
+	URL           string         `json:"url"`
+	Display       string         `json:"display"`
+	ASN           int64          `json:"asn"`
+	RIR           *RIR           `json:"rir,omitempty"`
+	Tenant        *NestedObject  `json:"tenant,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Comments      string         `json:"comments,omitempty"`
+	Tags          []models.Tag   `json:"tags,omitempty"`
+	CustomFields  map[string]any `json:"custom_fields,omitempty"`
+	Created       string         `json:"created"`
+	LastUpdated   string         `json:"last_updated"`
+	SiteCount     int            `json:"site_count"`
+	ProviderCount int            `json:"provider_count"`
+}
+
+// CreateASNInput represents the input for creating an ASN
+type CreateASNInput struct {
+	ASN          int64              `json:"asn"`
+	RIR          int                `json:"rir"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateASNInput
+func (input *CreateASNInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRange("asn", float64(input.ASN), float64(MinASN), float64(MaxASN)); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.RIR == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rir",
+			Message: "RIR is required",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateASNInput represents the input for updating an ASN
+type UpdateASNInput CreateASNInput
+
+// Validate validates the UpdateASNInput
+func (input *UpdateASNInput) Validate() error {
+	return (*CreateASNInput)(input).Validate()
+}
+
+// PatchASNInput represents the input for patching an ASN
+type PatchASNInput struct {
+	ASN          *int64              `json:"asn,omitempty"`
+	RIR          *int                `json:"rir,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchASNInput
+func (input *PatchASNInput) Validate() error {
+	if input.ASN != nil {
+		if err := models.ValidateRange("asn", float64(*input.ASN), float64(MinASN), float64(MaxASN)); err != nil {
+			return models.ValidationErrors{*err.(*models.ValidationError)}
+		}
+	}
+
+	return nil
+}
+
+// ListASNsInput represents the input for listing ASNs. Slice fields match any
+// of the given values.
+type ListASNsInput struct {
+	Query    string   `query:"q"`         // General search
+	ASN      []int64  `query:"asn"`       // Filter by AS number
+	ASNMin   int64    `query:"asn__gte"`  // Filter by AS numbers greater than or equal to the given value
+	ASNMax   int64    `query:"asn__lte"`  // Filter by AS numbers less than or equal to the given value
+	RIRID    []int    `query:"rir_id"`    // Filter by RIR ID
+	RIR      []string `query:"rir"`       // Filter by RIR slug
+	TenantID []int    `query:"tenant_id"` // Filter by tenant ID
+	SiteID   []int    `query:"site_id"`   // Filter by assigned site ID
+	Site     []string `query:"site"`      // Filter by assigned site slug
+	Tag      []string `query:"tag"`       // Filter by tag slug
+	Limit    int      `query:"limit"`     // Number of results to return per page
+	Offset   int      `query:"offset"`    // The initial index from which to return the results
+}
+
+// ASNRange represents a Netbox ASN range, a pool of AS numbers such as the
+// private range 64512-65534 from which ASNs are allocated
+type ASNRange struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Slug         string         `json:"slug"`
+	RIR          *RIR           `json:"rir"`
+	Start        int64          `json:"start"`
+	End          int64          `json:"end"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	ASNCount     int            `json:"asn_count"`
+}
+
+// CreateASNRangeInput represents the input for creating an ASN range. Both
+// bounds are inclusive.
+type CreateASNRangeInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	RIR          int                `json:"rir"`
+	Start        int64              `json:"start"`
+	End          int64              `json:"end"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateASNRangeInput
+func (input *CreateASNRangeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.RIR == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rir",
+			Message: "RIR is required",
+		})
+	}
+
+	if err := models.ValidateRange("start", float64(input.Start), float64(MinASN), float64(MaxASN)); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateRange("end", float64(input.End), float64(MinASN), float64(MaxASN)); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	} else if input.End < input.Start {
+		errors = append(errors, models.ValidationError{
+			Field:   "end",
+			Message: "must not be lower than start",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateASNRangeInput represents the input for updating an ASN range
+type UpdateASNRangeInput CreateASNRangeInput
+
+// Validate validates the UpdateASNRangeInput
+func (input *UpdateASNRangeInput) Validate() error {
+	return (*CreateASNRangeInput)(input).Validate()
+}
+
+// PatchASNRangeInput represents the input for patching an ASN range
+type PatchASNRangeInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	RIR          *int                `json:"rir,omitempty"`
+	Start        *int64              `json:"start,omitempty"`
+	End          *int64              `json:"end,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchASNRangeInput
+func (input *PatchASNRangeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Start != nil {
+		if err := models.ValidateRange("start", float64(*input.Start), float64(MinASN), float64(MaxASN)); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.End != nil {
+		if err := models.ValidateRange("end", float64(*input.End), float64(MinASN), float64(MaxASN)); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListASNRangesInput represents the input for listing ASN ranges
+type ListASNRangesInput struct {
+	Query    string   `query:"q"`         // General search
+	Name     []string `query:"name"`      // Filter by name (exact match)
+	Slug     []string `query:"slug"`      // Filter by slug
+	RIRID    []int    `query:"rir_id"`    // Filter by RIR ID
+	RIR      []string `query:"rir"`       // Filter by RIR slug
+	TenantID []int    `query:"tenant_id"` // Filter by tenant ID
+	Tag      []string `query:"tag"`       // Filter by tag slug
+	Limit    int      `query:"limit"`     // Number of results to return per page
+	Offset   int      `query:"offset"`    // The initial index from which to return the results
+}
+
+// AvailableASN represents an unallocated AS number within an ASN range
+type AvailableASN struct {
+	ASN int64 `json:"asn"`
+}
+
+// AllocateASNInput represents the input for allocating an ASN from an ASN
+// range. The AS number and RIR are assigned by Netbox.
+type AllocateASNInput struct {
+	Tenant       int                `json:"tenant,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
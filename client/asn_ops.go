@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ASNResource is the typed resource for ipam/asns
+type ASNResource = Resource[ASN, CreateASNInput, UpdateASNInput, PatchASNInput, ListASNsInput]
+
+// ASNs returns the typed resource for ipam/asns
+func (c *Client) ASNs() *ASNResource {
+	return NewResource[ASN, CreateASNInput, UpdateASNInput, PatchASNInput, ListASNsInput](c, "ipam", "asns")
+}
+
+// ASNRangeResource is the typed resource for ipam/asn-ranges
+type ASNRangeResource = Resource[ASNRange, CreateASNRangeInput, UpdateASNRangeInput, PatchASNRangeInput, ListASNRangesInput]
+
+// ASNRanges returns the typed resource for ipam/asn-ranges
+func (c *Client) ASNRanges() *ASNRangeResource {
+	return NewResource[ASNRange, CreateASNRangeInput, UpdateASNRangeInput, PatchASNRangeInput, ListASNRangesInput](c, "ipam", "asn-ranges")
+}
+
+// ListAvailableASNs lists up to limit unallocated AS numbers in an ASN range.
+// A limit of 0 uses the server's default.
+func (c *Client) ListAvailableASNs(rangeID int, limit int) ([]AvailableASN, error) {
+	return c.ListAvailableASNsWithContext(context.Background(), rangeID, limit)
+}
+
+// ListAvailableASNsWithContext lists up to limit unallocated AS numbers in an ASN range using the provided context
+func (c *Client) ListAvailableASNsWithContext(ctx context.Context, rangeID int, limit int) ([]AvailableASN, error) {
+	req := c.RWithContext(ctx)
+	if limit > 0 {
+		req.SetQueryParam("limit", fmt.Sprintf("%d", limit))
+	}
+
+	available := make([]AvailableASN, 0)
+	resp, err := req.
+		SetResult(&available).
+		Get(c.BuildPath("ipam", "asn-ranges", fmt.Sprintf("%d", rangeID), "available-asns"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing available ASNs: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return available, nil
+}
+
+// AllocateASN allocates the lowest free AS number of an ASN range, for
+// example the next private ASN for a new site
+func (c *Client) AllocateASN(rangeID int, input *AllocateASNInput) (*ASN, error) {
+	return c.AllocateASNWithContext(context.Background(), rangeID, input)
+}
+
+// AllocateASNWithContext allocates the lowest free AS number of an ASN range using the provided context
+func (c *Client) AllocateASNWithContext(ctx context.Context, rangeID int, input *AllocateASNInput) (*ASN, error) {
+	if input == nil {
+		input = &AllocateASNInput{}
+	}
+
+	var asn ASN
+	resp, err := c.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&asn).
+		Post(c.BuildPath("ipam", "asn-ranges", fmt.Sprintf("%d", rangeID), "available-asns"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error allocating ASN: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &asn, nil
+}
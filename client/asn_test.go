@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestAllocateASNForSite(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/asn-ranges/2/available-asns/":
+			assert.Equal(t, "1", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`[{"asn": 64513}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/asn-ranges/2/available-asns/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "LON1", body["description"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 9, "asn": 64513, "rir": {"id": 1, "slug": "private", "is_private": true}, "description": "LON1"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/dcim/sites/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []any{float64(9)}, body["asns"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1, "name": "LON1", "slug": "lon1", "asns": [{"id": 9, "display": "AS64513"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	available, err := client.ListAvailableASNs(2, 1)
+	require.NoError(t, err)
+	require.Len(t, available, 1)
+	assert.Equal(t, int64(64513), available[0].ASN)
+
+	asn, err := client.AllocateASN(2, &AllocateASNInput{Description: "LON1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(64513), asn.ASN)
+	assert.True(t, asn.RIR.IsPrivate)
+
+	site, err := client.CreateSite(&CreateSiteInput{Name: "LON1", Slug: "lon1", ASNs: []int{asn.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, asn.ID, site.ASNs[0].ID)
+}
+
+func TestASNRangeValidation(t *testing.T) {
+	err := (&CreateASNRangeInput{Name: "Private", Slug: "private", RIR: 1, Start: 65534, End: 64512}).Validate()
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+	require.Len(t, validationErrors, 1)
+	assert.Equal(t, "end", validationErrors[0].Field)
+
+	assert.NoError(t, (&CreateASNRangeInput{Name: "Private", Slug: "private", RIR: 1, Start: 4200000000, End: MaxASN}).Validate())
+	assert.Error(t, (&CreateASNInput{ASN: MaxASN + 1, RIR: 1}).Validate())
+}
@@ -0,0 +1,404 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for cables
+const (
+	CableStatusConnected       = "connected"
+	CableStatusPlanned         = "planned"
+	CableStatusDecommissioning = "decommissioning"
+)
+
+// cableStatuses lists all valid cable status values
+var cableStatuses = []string{
+	CableStatusConnected,
+	CableStatusPlanned,
+	CableStatusDecommissioning,
+}
+
+// Valid length units for cables
+const (
+	CableLengthUnitKilometers  = "km"
+	CableLengthUnitMeters      = "m"
+	CableLengthUnitCentimeters = "cm"
+	CableLengthUnitMiles       = "mi"
+	CableLengthUnitFeet        = "ft"
+	CableLengthUnitInches      = "in"
+)
+
+// cableLengthUnits lists all valid cable length units
+var cableLengthUnits = []string{
+	CableLengthUnitKilometers,
+	CableLengthUnitMeters,
+	CableLengthUnitCentimeters,
+	CableLengthUnitMiles,
+	CableLengthUnitFeet,
+	CableLengthUnitInches,
+}
+
+// Object types which can be attached to either end of a cable
+const (
+	TerminationTypeInterface          = "dcim.interface"
+	TerminationTypeFrontPort          = "dcim.frontport"
+	TerminationTypeRearPort           = "dcim.rearport"
+	TerminationTypeConsolePort        = "dcim.consoleport"
+	TerminationTypeConsoleServerPort  = "dcim.consoleserverport"
+	TerminationTypePowerPort          = "dcim.powerport"
+	TerminationTypePowerOutlet        = "dcim.poweroutlet"
+	TerminationTypePowerFeed          = "dcim.powerfeed"
+	TerminationTypeCircuitTermination = "circuits.circuittermination"
+)
+
+// terminationTypes lists all valid cable termination object types
+var terminationTypes = []string{
+	TerminationTypeInterface,
+	TerminationTypeFrontPort,
+	TerminationTypeRearPort,
+	TerminationTypeConsolePort,
+	TerminationTypeConsoleServerPort,
+	TerminationTypePowerPort,
+	TerminationTypePowerOutlet,
+	TerminationTypePowerFeed,
+	TerminationTypeCircuitTermination,
+}
+
+// CableEndpoint represents an object at the end of a cable, such as a device
+// interface or a circuit termination
+type CableEndpoint struct {
+	ID          int           `json:"id"`
+	URL         string        `json:"url"`
+	Display     string        `json:"display"`
+	Name        string        `json:"name,omitempty"`
+	Device      *Device       `json:"device,omitempty"`
+	Circuit     *NestedObject `json:"circuit,omitempty"`
+	TermSide    string        `json:"term_side,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Cable       *NestedObject `json:"cable,omitempty"`
+	Occupied    bool          `json:"_occupied"`
+}
+
+// CableTermination represents an object attached to one end of a cable. On
+// write only ObjectType and ObjectID are sent; Object is populated on read.
+type CableTermination struct {
+	ObjectType string         `json:"object_type"`
+	ObjectID   int            `json:"object_id"`
+	Object     *CableEndpoint `json:"object,omitempty"`
+}
+
+// Cable represents a Netbox cable
+type Cable struct {
+	ID            int                `json:"id"`
+	URL           string             `json:"url"`
+	Display       string             `json:"display"`
+	Type          string             `json:"type,omitempty"`
+	ATerminations []CableTermination `json:"a_terminations,omitempty"`
+	BTerminations []CableTermination `json:"b_terminations,omitempty"`
+	Status        *Status            `json:"status,omitempty"`
+	Tenant        *NestedObject      `json:"tenant,omitempty"`
+	Label         string             `json:"label,omitempty"`
+	Color         string             `json:"color,omitempty"`
+	Length        *float64           `json:"length,omitempty"`
+	LengthUnit    *Choice            `json:"length_unit,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Comments      string             `json:"comments,omitempty"`
+	Tags          []models.Tag       `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+	Created       string             `json:"created,omitempty"`
+	LastUpdated   string             `json:"last_updated,omitempty"`
+}
+
+// CreateCableInput represents the input for creating a cable
+type CreateCableInput struct {
+	Type          string             `json:"type,omitempty"`
+	ATerminations []CableTermination `json:"a_terminations"`
+	BTerminations []CableTermination `json:"b_terminations"`
+	Status        string             `json:"status,omitempty"`
+	Tenant        int                `json:"tenant,omitempty"`
+	Label         string             `json:"label,omitempty"`
+	Color         string             `json:"color,omitempty"`
+	Length        *float64           `json:"length,omitempty"`
+	LengthUnit    string             `json:"length_unit,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Comments      string             `json:"comments,omitempty"`
+	Tags          []models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateCableInput
+func (input *CreateCableInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if len(input.ATerminations) == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "a_terminations",
+			Message: "cannot be empty",
+		})
+	}
+	errors = append(errors, validateCableTerminations("a_terminations", input.ATerminations)...)
+
+	if len(input.BTerminations) == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "b_terminations",
+			Message: "cannot be empty",
+		})
+	}
+	errors = append(errors, validateCableTerminations("b_terminations", input.BTerminations)...)
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, cableStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	errors = append(errors, validateCableLength(input.Length, input.LengthUnit)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateCableInput represents the input for updating a cable
+type UpdateCableInput CreateCableInput
+
+// Validate validates the UpdateCableInput
+func (input *UpdateCableInput) Validate() error {
+	return (*CreateCableInput)(input).Validate()
+}
+
+// PatchCableInput represents the input for patching a cable
+type PatchCableInput struct {
+	Type          *string             `json:"type,omitempty"`
+	ATerminations *[]CableTermination `json:"a_terminations,omitempty"`
+	BTerminations *[]CableTermination `json:"b_terminations,omitempty"`
+	Status        *string             `json:"status,omitempty"`
+	Tenant        *int                `json:"tenant,omitempty"`
+	Label         *string             `json:"label,omitempty"`
+	Color         *string             `json:"color,omitempty"`
+	Length        *float64            `json:"length,omitempty"`
+	LengthUnit    *string             `json:"length_unit,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Comments      *string             `json:"comments,omitempty"`
+	Tags          *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchCableInput
+func (input *PatchCableInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.ATerminations != nil {
+		if len(*input.ATerminations) == 0 {
+			errors = append(errors, models.ValidationError{
+				Field:   "a_terminations",
+				Message: "cannot be empty",
+			})
+		}
+		errors = append(errors, validateCableTerminations("a_terminations", *input.ATerminations)...)
+	}
+
+	if input.BTerminations != nil {
+		if len(*input.BTerminations) == 0 {
+			errors = append(errors, models.ValidationError{
+				Field:   "b_terminations",
+				Message: "cannot be empty",
+			})
+		}
+		errors = append(errors, validateCableTerminations("b_terminations", *input.BTerminations)...)
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, cableStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.LengthUnit != nil && *input.LengthUnit != "" {
+		if err := models.ValidateOneOf("length_unit", *input.LengthUnit, cableLengthUnits...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateCableTerminations checks that every termination names a supported
+// object type and an object ID. All ends of a cable must be of the same type.
+func validateCableTerminations(field string, terminations []CableTermination) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	for i, termination := range terminations {
+		entry := fmt.Sprintf("%s.%d", field, i)
+
+		if err := models.ValidateOneOf(entry+".object_type", termination.ObjectType, terminationTypes...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+
+		if termination.ObjectID == 0 {
+			errors = append(errors, models.ValidationError{
+				Field:   entry + ".object_id",
+				Message: "Object ID is required",
+			})
+		}
+
+		if termination.ObjectType != terminations[0].ObjectType {
+			errors = append(errors, models.ValidationError{
+				Field:   entry + ".object_type",
+				Message: "all terminations on one end of a cable must be of the same type",
+			})
+		}
+	}
+
+	return errors
+}
+
+// validateCableLength checks that a length unit is a known value and is
+// supplied whenever a length is set
+func validateCableLength(length *float64, unit string) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if length != nil && *length < 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "length",
+			Message: "cannot be negative",
+		})
+	}
+
+	if unit != "" {
+		if err := models.ValidateOneOf("length_unit", unit, cableLengthUnits...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	} else if length != nil {
+		errors = append(errors, models.ValidationError{
+			Field:   "length_unit",
+			Message: "is required when length is set",
+		})
+	}
+
+	return errors
+}
+
+// ListCablesInput represents the input for listing cables
+type ListCablesInput struct {
+	Query        string   `query:"q"`            // General search
+	Label        string   `query:"label__ic"`    // Filter by label (case-insensitive contains)
+	Type         []string `query:"type"`         // Filter by cable type
+	Status       []string `query:"status"`       // Filter by status
+	Color        []string `query:"color"`        // Filter by color
+	SiteID       []int    `query:"site_id"`      // Filter by site ID of either end
+	LocationID   []int    `query:"location_id"`  // Filter by location ID of either end
+	RackID       []int    `query:"rack_id"`      // Filter by rack ID of either end
+	DeviceID     []int    `query:"device_id"`    // Filter by device ID of either end
+	InterfaceID  []int    `query:"interface_id"` // Filter by interface ID of either end
+	TenantID     []int    `query:"tenant_id"`    // Filter by tenant ID
+	Unterminated *bool    `query:"unterminated"` // Filter by whether either end is missing
+	Tag          []string `query:"tag"`          // Filter by tag slug
+	Limit        int      `query:"limit"`        // Number of results to return per page
+	Offset       int      `query:"offset"`       // The initial index from which to return the results
+}
+
+// PathNode represents an object along a cable path, such as an interface, a
+// pass-through port, a cable or a circuit termination
+type PathNode struct {
+	CableEndpoint
+
+	// ObjectType is the type of the node, for example "dcim.interface" or
+	// "dcim.cable". Netbox does not return it, so it is derived from the
+	// object's API URL.
+	ObjectType string `json:"-"`
+}
+
+// UnmarshalJSON decodes a path node and derives its object type
+func (n *PathNode) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &n.CableEndpoint); err != nil {
+		return err
+	}
+
+	n.ObjectType = objectTypeFromURL(n.URL)
+
+	return nil
+}
+
+// TraceHop represents one segment of a cable trace: the terminations at the
+// near end, the cable joining them (if any) and the terminations at the far end
+type TraceHop struct {
+	NearEnds []PathNode
+	Cable    *Cable
+	FarEnds  []PathNode
+}
+
+// UnmarshalJSON decodes a trace segment, which Netbox returns as a
+// three-element array of near ends, cable and far ends
+func (h *TraceHop) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if len(raw) != 3 {
+		return fmt.Errorf("error decoding trace segment: expected 3 elements, got %d", len(raw))
+	}
+
+	if err := json.Unmarshal(raw[0], &h.NearEnds); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw[1], &h.Cable); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw[2], &h.FarEnds)
+}
+
+// CablePath represents a path from a front or rear port through cables and
+// pass-through ports. Path holds each step in order, alternating between
+// terminations and the cables joining them.
+type CablePath struct {
+	ID         int          `json:"id"`
+	Path       [][]PathNode `json:"path"`
+	IsActive   bool         `json:"is_active"`
+	IsComplete bool         `json:"is_complete"`
+	IsSplit    bool         `json:"is_split"`
+}
+
+// pathObjectTypes maps the API endpoints of objects found along cable paths to
+// their Netbox object types
+var pathObjectTypes = map[string]string{
+	"dcim/interfaces":               TerminationTypeInterface,
+	"dcim/front-ports":              TerminationTypeFrontPort,
+	"dcim/rear-ports":               TerminationTypeRearPort,
+	"dcim/console-ports":            TerminationTypeConsolePort,
+	"dcim/console-server-ports":     TerminationTypeConsoleServerPort,
+	"dcim/power-ports":              TerminationTypePowerPort,
+	"dcim/power-outlets":            TerminationTypePowerOutlet,
+	"dcim/power-feeds":              TerminationTypePowerFeed,
+	"dcim/cables":                   "dcim.cable",
+	"circuits/circuit-terminations": TerminationTypeCircuitTermination,
+	"circuits/provider-networks":    "circuits.providernetwork",
+}
+
+// objectTypeFromURL looks up the Netbox object type such as "dcim.frontport"
+// of an API URL such as "https://netbox/api/dcim/front-ports/1/". It returns
+// an empty string for endpoints which cannot appear along a cable path.
+func objectTypeFromURL(url string) string {
+	parts := strings.Split(strings.Trim(url, "/"), "/")
+
+	for i := len(parts) - 3; i >= 0; i-- {
+		if parts[i] == "api" {
+			return pathObjectTypes[parts[i+1]+"/"+parts[i+2]]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CableResource is the typed resource for dcim/cables
+type CableResource = Resource[Cable, CreateCableInput, UpdateCableInput, PatchCableInput, ListCablesInput]
+
+// Cables returns the typed resource for dcim/cables
+func (c *Client) Cables() *CableResource {
+	return NewResource[Cable, CreateCableInput, UpdateCableInput, PatchCableInput, ListCablesInput](c, "dcim", "cables")
+}
+
+// TraceInterface traces the cable path starting at an interface, returning
+// each segment in order from the interface to the far endpoint
+func (c *Client) TraceInterface(interfaceID int) ([]TraceHop, error) {
+	return c.TraceInterfaceWithContext(context.Background(), interfaceID)
+}
+
+// TraceInterfaceWithContext traces the cable path starting at an interface using the provided context
+func (c *Client) TraceInterfaceWithContext(ctx context.Context, interfaceID int) ([]TraceHop, error) {
+	return c.trace(ctx, "interfaces", interfaceID)
+}
+
+// GetFrontPortPaths retrieves every cable path which passes through a front port
+func (c *Client) GetFrontPortPaths(frontPortID int) ([]CablePath, error) {
+	return c.GetFrontPortPathsWithContext(context.Background(), frontPortID)
+}
+
+// GetFrontPortPathsWithContext retrieves every cable path which passes through a front port using the provided context
+func (c *Client) GetFrontPortPathsWithContext(ctx context.Context, frontPortID int) ([]CablePath, error) {
+	return c.paths(ctx, "front-ports", frontPortID)
+}
+
+// GetRearPortPaths retrieves every cable path which passes through a rear port
+func (c *Client) GetRearPortPaths(rearPortID int) ([]CablePath, error) {
+	return c.GetRearPortPathsWithContext(context.Background(), rearPortID)
+}
+
+// GetRearPortPathsWithContext retrieves every cable path which passes through a rear port using the provided context
+func (c *Client) GetRearPortPathsWithContext(ctx context.Context, rearPortID int) ([]CablePath, error) {
+	return c.paths(ctx, "rear-ports", rearPortID)
+}
+
+// trace retrieves the cable trace of a path endpoint such as an interface,
+// console port or power port
+func (c *Client) trace(ctx context.Context, endpoint string, id int) ([]TraceHop, error) {
+	path := c.BuildPath("dcim", endpoint, fmt.Sprintf("%d", id), "trace")
+
+	hops := make([]TraceHop, 0)
+	resp, err := c.RWithContext(ctx).
+		SetResult(&hops).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error tracing cable path: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return hops, nil
+}
+
+// paths retrieves the cable paths through a pass-through port
+func (c *Client) paths(ctx context.Context, endpoint string, id int) ([]CablePath, error) {
+	path := c.BuildPath("dcim", endpoint, fmt.Sprintf("%d", id), "paths")
+
+	paths := make([]CablePath, 0)
+	resp, err := c.RWithContext(ctx).
+		SetResult(&paths).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting cable paths: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceInterface(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/interfaces/7/trace/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			[
+				[{"id": 7, "url": "http://netbox/api/dcim/interfaces/7/", "name": "eth0", "device": {"id": 1, "name": "sw1"},
+					"cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "patch-1"}, "_occupied": true}],
+				{"id": 30, "url": "http://netbox/api/dcim/cables/30/", "label": "patch-1", "status": {"value": "connected"}},
+				[{"id": 3, "url": "http://netbox/api/dcim/front-ports/3/", "name": "1", "device": {"id": 2, "name": "panel"},
+					"cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "patch-1"}, "_occupied": true}]
+			],
+			[
+				[{"id": 4, "url": "http://netbox/api/dcim/rear-ports/4/", "name": "1",
+					"cable": {"id": 31, "url": "http://netbox/api/dcim/cables/31/", "display": "#31"}}],
+				{"id": 31, "url": "http://netbox/api/dcim/cables/31/", "status": {"value": "connected"}},
+				[{"id": 9, "url": "http://netbox/api/circuits/circuit-terminations/9/", "term_side": "A",
+					"circuit": {"id": 2, "display": "CID-100"}, "cable": {"id": 31, "url": "http://netbox/api/dcim/cables/31/", "display": "#31"}}]
+			]
+		]`))
+	})
+
+	client := newTestClient(t, ts)
+
+	hops, err := client.TraceInterface(7)
+	require.NoError(t, err)
+	require.Len(t, hops, 2)
+
+	require.Len(t, hops[0].NearEnds, 1)
+	assert.Equal(t, TerminationTypeInterface, hops[0].NearEnds[0].ObjectType)
+	assert.Equal(t, "sw1", hops[0].NearEnds[0].Device.Name)
+	require.NotNil(t, hops[0].Cable)
+	assert.Equal(t, "patch-1", hops[0].Cable.Label)
+	assert.Equal(t, TerminationTypeFrontPort, hops[0].FarEnds[0].ObjectType)
+	assert.Equal(t, 30, hops[0].FarEnds[0].Cable.ID)
+
+	assert.Equal(t, TerminationTypeRearPort, hops[1].NearEnds[0].ObjectType)
+	assert.Equal(t, 31, hops[1].Cable.ID)
+	require.Len(t, hops[1].FarEnds, 1)
+	assert.Equal(t, TerminationTypeCircuitTermination, hops[1].FarEnds[0].ObjectType)
+	assert.Equal(t, "CID-100", hops[1].FarEnds[0].Circuit.Display)
+}
+
+func TestTraceInterfaceIncomplete(t *testing.T) {
+	client := newMockClient(t, "/api/dcim/interfaces/8/trace/", `[
+		[
+			[{"id": 8, "url": "http://netbox/api/dcim/interfaces/8/", "name": "eth1"}],
+			null,
+			[]
+		]
+	]`, http.StatusOK)
+
+	hops, err := client.TraceInterface(8)
+	require.NoError(t, err)
+	require.Len(t, hops, 1)
+	assert.Nil(t, hops[0].Cable)
+	assert.Empty(t, hops[0].FarEnds)
+}
+
+func TestObjectTypeFromURL(t *testing.T) {
+	assert.Equal(t, TerminationTypeConsoleServerPort, objectTypeFromURL("http://netbox/api/dcim/console-server-ports/1/"))
+	assert.Equal(t, TerminationTypeCircuitTermination, objectTypeFromURL("https://netbox/api/circuits/circuit-terminations/2/"))
+	assert.Equal(t, "circuits.providernetwork", objectTypeFromURL("https://netbox/api/circuits/provider-networks/3/"))
+	assert.Equal(t, "", objectTypeFromURL("https://netbox/api/ipam/ip-addresses/4/"))
+}
+
+func TestGetFrontPortPaths(t *testing.T) {
+	client := newMockClient(t, "/api/dcim/front-ports/3/paths/", `[{
+		"id": 11,
+		"path": [
+			[{"id": 7, "url": "http://netbox/api/dcim/interfaces/7/", "cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "#30"}}],
+			[{"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "#30"}],
+			[{"id": 3, "url": "http://netbox/api/dcim/front-ports/3/", "cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "#30"}}]
+		],
+		"is_active": true,
+		"is_complete": false,
+		"is_split": false
+	}]`, http.StatusOK)
+
+	paths, err := client.GetFrontPortPaths(3)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.True(t, paths[0].IsActive)
+	require.Len(t, paths[0].Path, 3)
+	assert.Equal(t, TerminationTypeInterface, paths[0].Path[0][0].ObjectType)
+	assert.Equal(t, "dcim.cable", paths[0].Path[1][0].ObjectType)
+	assert.Equal(t, 3, paths[0].Path[2][0].ID)
+	assert.Equal(t, 30, paths[0].Path[2][0].Cable.ID)
+}
+
+func TestCreateCableInputValidate(t *testing.T) {
+	length := 2.5
+
+	assert.NoError(t, (&CreateCableInput{
+		ATerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 1}},
+		BTerminations: []CableTermination{{ObjectType: TerminationTypeCircuitTermination, ObjectID: 2}},
+		Status:        CableStatusConnected,
+		Length:        &length,
+		LengthUnit:    CableLengthUnitMeters,
+	}).Validate())
+
+	assert.Error(t, (&CreateCableInput{
+		ATerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 1}},
+	}).Validate())
+
+	assert.Error(t, (&CreateCableInput{
+		ATerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 1}, {ObjectType: TerminationTypeFrontPort, ObjectID: 2}},
+		BTerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 3}},
+	}).Validate())
+
+	assert.Error(t, (&CreateCableInput{
+		ATerminations: []CableTermination{{ObjectType: "dcim.device", ObjectID: 1}},
+		BTerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 3}},
+	}).Validate())
+
+	assert.Error(t, (&CreateCableInput{
+		ATerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 1}},
+		BTerminations: []CableTermination{{ObjectType: TerminationTypeInterface, ObjectID: 3}},
+		Length:        &length,
+	}).Validate())
+}
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/zeddD1abl0/go-netbox-client/models"
 )
 
 const (
@@ -32,11 +34,23 @@ func WithRetry(count int, waitTime int) ClientOption {
 			SetRetryCount(count).
 			SetRetryWaitTime(time.Duration(waitTime) * time.Second).
 			AddRetryCondition(func(r *resty.Response, err error) bool {
+				// Never retry once the caller has given up on the request
+				if r != nil && r.Request != nil && r.Request.Context().Err() != nil {
+					return false
+				}
 				return err != nil || r.StatusCode() >= 500
 			})
 	}
 }
 
+// WithoutValidation disables the local Validate() checks run before
+// create, update and patch requests are sent
+func WithoutValidation() ClientOption {
+	return func(c *Client) {
+		c.skipValidation = true
+	}
+}
+
 // HTTPClient represents an HTTP client interface
 type HTTPClient interface {
 	R() *resty.Request
@@ -44,9 +58,10 @@ type HTTPClient interface {
 
 // Client represents a Netbox API client
 type Client struct {
-	httpClient HTTPClient
-	baseURL    string
-	token      string
+	httpClient     HTTPClient
+	baseURL        string
+	token          string
+	skipValidation bool
 }
 
 // NewClient creates a new Netbox client
@@ -58,10 +73,12 @@ func NewClient(baseURL, token string, opts ...ClientOption) (*Client, error) {
 		return nil, fmt.Errorf("token cannot be empty")
 	}
 
-	// Ensure baseURL ends with /api
+	// Ensure baseURL ends with /api/
+	baseURL = strings.TrimSuffix(baseURL, "/")
 	if !strings.HasSuffix(baseURL, "/api") {
-		baseURL = strings.TrimSuffix(baseURL, "/") + "/api"
+		baseURL += "/api"
 	}
+	baseURL += "/"
 
 	// Create HTTP client
 	httpClient := resty.New().
@@ -91,6 +108,25 @@ func (c *Client) R() *resty.Request {
 	return c.httpClient.R()
 }
 
+// RWithContext returns a new request object bound to the given context.
+// Cancelling the context aborts the request and any pending retries.
+func (c *Client) RWithContext(ctx context.Context) *resty.Request {
+	return c.httpClient.R().SetContext(ctx)
+}
+
+// validate runs the input's Validate method, if it has one, unless validation is disabled
+func (c *Client) validate(input any) error {
+	if c.skipValidation {
+		return nil
+	}
+
+	if v, ok := input.(models.Validator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
 // BuildPath builds a full API path from the given parts
 func (c *Client) BuildPath(parts ...string) string {
 	path := c.baseURL
@@ -102,8 +138,8 @@ func (c *Client) BuildPath(parts ...string) string {
 
 // Response represents a paginated response from the Netbox API
 type Response struct {
-	Count    int   `json:"count"`
-	Next     *int  `json:"next"`
-	Previous *int  `json:"previous"`
-	Results  []any `json:"results"`
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []any   `json:"results"`
 }
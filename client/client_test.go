@@ -1,7 +1,10 @@
 package client
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,3 +55,27 @@ func TestNewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildPath(t *testing.T) {
+	client, err := NewClient("http://127.0.0.1:8000", "test-token")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8000/api/dcim/sites/", client.BuildPath("dcim", "sites"))
+	assert.Equal(t, "http://127.0.0.1:8000/api/dcim/sites/1/", client.BuildPath("dcim", "sites", "1"))
+}
+
+func TestContextCancellation(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	client := newTestClient(t, ts, WithRetry(3, 5))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetSiteWithContext(ctx, 1)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
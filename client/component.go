@@ -0,0 +1,122 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Component holds the fields shared by every device component
+type Component struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Device       *Device        `json:"device"`
+	Module       *NestedObject  `json:"module,omitempty"`
+	Name         string         `json:"name"`
+	Label        string         `json:"label,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CableState holds the cabling fields of components which accept a cable
+type CableState struct {
+	MarkConnected bool            `json:"mark_connected"`
+	Cable         *NestedObject   `json:"cable,omitempty"`
+	CableEnd      string          `json:"cable_end,omitempty"`
+	LinkPeers     []CableEndpoint `json:"link_peers,omitempty"`
+	LinkPeersType string          `json:"link_peers_type,omitempty"`
+	Occupied      bool            `json:"_occupied"`
+}
+
+// IsCabled reports whether a cable is attached or the component is marked as connected
+func (s *CableState) IsCabled() bool {
+	return s.Cable != nil || s.MarkConnected
+}
+
+// ConnectionState holds the far-end connection of components which terminate
+// a cable path, such as console and power ports
+type ConnectionState struct {
+	ConnectedEndpoints          []CableEndpoint `json:"connected_endpoints,omitempty"`
+	ConnectedEndpointsType      string          `json:"connected_endpoints_type,omitempty"`
+	ConnectedEndpointsReachable bool            `json:"connected_endpoints_reachable"`
+}
+
+// IsConnected reports whether the cable path reaches at least one endpoint
+func (s *ConnectionState) IsConnected() bool {
+	return len(s.ConnectedEndpoints) > 0
+}
+
+// ComponentInput holds the writable fields shared by every device component
+type ComponentInput struct {
+	Device       int                `json:"device"`
+	Module       int                `json:"module,omitempty"`
+	Name         string             `json:"name"`
+	Label        string             `json:"label,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// validate checks the device and name of a component
+func (input *ComponentInput) validate() models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if input.Device == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device",
+			Message: "Device is required",
+		})
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	return errors
+}
+
+// PatchComponentInput holds the patchable fields shared by every device component
+type PatchComponentInput struct {
+	Device       *int                `json:"device,omitempty"`
+	Module       *int                `json:"module,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+	Label        *string             `json:"label,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// validate checks the name of a component patch
+func (input *PatchComponentInput) validate() models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	return errors
+}
+
+// ListComponentsInput represents the input for listing device components of
+// any kind. Slice fields match any of the given values.
+type ListComponentsInput struct {
+	Query       string   `query:"q"`             // General search
+	ID          []int    `query:"id"`            // Filter by component ID
+	DeviceID    []int    `query:"device_id"`     // Filter by device ID
+	Device      []string `query:"device"`        // Filter by device name
+	ModuleID    []int    `query:"module_id"`     // Filter by module ID
+	SiteID      []int    `query:"site_id"`       // Filter by site ID
+	RackID      []int    `query:"rack_id"`       // Filter by rack ID
+	Name        []string `query:"name"`          // Filter by name (exact match)
+	Cabled      *bool    `query:"cabled"`        // Filter by whether a cable is attached
+	Connected   *bool    `query:"connected"`     // Filter by whether the cable path is connected (console and power components only)
+	Occupied    *bool    `query:"occupied"`      // Filter by whether a cable is attached or the component is marked connected
+	PowerPortID []int    `query:"power_port_id"` // Filter by feeding power port ID (power outlets only)
+	Tag         []string `query:"tag"`           // Filter by tag slug
+	Limit       int      `query:"limit"`         // Number of results to return per page
+	Offset      int      `query:"offset"`        // The initial index from which to return the results
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+)
+
+// ConsolePortResource is the typed resource for dcim/console-ports
+type ConsolePortResource = Resource[ConsolePort, CreateConsolePortInput, UpdateConsolePortInput, PatchConsolePortInput, ListComponentsInput]
+
+// ConsolePorts returns the typed resource for dcim/console-ports
+func (c *Client) ConsolePorts() *ConsolePortResource {
+	return NewResource[ConsolePort, CreateConsolePortInput, UpdateConsolePortInput, PatchConsolePortInput, ListComponentsInput](c, "dcim", "console-ports")
+}
+
+// ConsoleServerPortResource is the typed resource for dcim/console-server-ports
+type ConsoleServerPortResource = Resource[ConsoleServerPort, CreateConsoleServerPortInput, UpdateConsoleServerPortInput, PatchConsoleServerPortInput, ListComponentsInput]
+
+// ConsoleServerPorts returns the typed resource for dcim/console-server-ports
+func (c *Client) ConsoleServerPorts() *ConsoleServerPortResource {
+	return NewResource[ConsoleServerPort, CreateConsoleServerPortInput, UpdateConsoleServerPortInput, PatchConsoleServerPortInput, ListComponentsInput](c, "dcim", "console-server-ports")
+}
+
+// PowerPortResource is the typed resource for dcim/power-ports
+type PowerPortResource = Resource[PowerPort, CreatePowerPortInput, UpdatePowerPortInput, PatchPowerPortInput, ListComponentsInput]
+
+// PowerPorts returns the typed resource for dcim/power-ports
+func (c *Client) PowerPorts() *PowerPortResource {
+	return NewResource[PowerPort, CreatePowerPortInput, UpdatePowerPortInput, PatchPowerPortInput, ListComponentsInput](c, "dcim", "power-ports")
+}
+
+// PowerOutletResource is the typed resource for dcim/power-outlets
+type PowerOutletResource = Resource[PowerOutlet, CreatePowerOutletInput, UpdatePowerOutletInput, PatchPowerOutletInput, ListComponentsInput]
+
+// PowerOutlets returns the typed resource for dcim/power-outlets
+func (c *Client) PowerOutlets() *PowerOutletResource {
+	return NewResource[PowerOutlet, CreatePowerOutletInput, UpdatePowerOutletInput, PatchPowerOutletInput, ListComponentsInput](c, "dcim", "power-outlets")
+}
+
+// FrontPortResource is the typed resource for dcim/front-ports
+type FrontPortResource = Resource[FrontPort, CreateFrontPortInput, UpdateFrontPortInput, PatchFrontPortInput, ListComponentsInput]
+
+// FrontPorts returns the typed resource for dcim/front-ports
+func (c *Client) FrontPorts() *FrontPortResource {
+	return NewResource[FrontPort, CreateFrontPortInput, UpdateFrontPortInput, PatchFrontPortInput, ListComponentsInput](c, "dcim", "front-ports")
+}
+
+// RearPortResource is the typed resource for dcim/rear-ports
+type RearPortResource = Resource[RearPort, CreateRearPortInput, UpdateRearPortInput, PatchRearPortInput, ListComponentsInput]
+
+// RearPorts returns the typed resource for dcim/rear-ports
+func (c *Client) RearPorts() *RearPortResource {
+	return NewResource[RearPort, CreateRearPortInput, UpdateRearPortInput, PatchRearPortInput, ListComponentsInput](c, "dcim", "rear-ports")
+}
+
+// DeviceBayResource is the typed resource for dcim/device-bays
+type DeviceBayResource = Resource[DeviceBay, CreateDeviceBayInput, UpdateDeviceBayInput, PatchDeviceBayInput, ListComponentsInput]
+
+// DeviceBays returns the typed resource for dcim/device-bays
+func (c *Client) DeviceBays() *DeviceBayResource {
+	return NewResource[DeviceBay, CreateDeviceBayInput, UpdateDeviceBayInput, PatchDeviceBayInput, ListComponentsInput](c, "dcim", "device-bays")
+}
+
+// ModuleBayResource is the typed resource for dcim/module-bays
+type ModuleBayResource = Resource[ModuleBay, CreateModuleBayInput, UpdateModuleBayInput, PatchModuleBayInput, ListComponentsInput]
+
+// ModuleBays returns the typed resource for dcim/module-bays
+func (c *Client) ModuleBays() *ModuleBayResource {
+	return NewResource[ModuleBay, CreateModuleBayInput, UpdateModuleBayInput, PatchModuleBayInput, ListComponentsInput](c, "dcim", "module-bays")
+}
+
+// InventoryItemResource is the typed resource for dcim/inventory-items
+type InventoryItemResource = Resource[InventoryItem, CreateInventoryItemInput, UpdateInventoryItemInput, PatchInventoryItemInput, ListComponentsInput]
+
+// InventoryItems returns the typed resource for dcim/inventory-items
+func (c *Client) InventoryItems() *InventoryItemResource {
+	return NewResource[InventoryItem, CreateInventoryItemInput, UpdateInventoryItemInput, PatchInventoryItemInput, ListComponentsInput](c, "dcim", "inventory-items")
+}
+
+// TraceConsolePort traces the cable path starting at a console port, returning each
+// segment in order from the console port to the far endpoint
+func (c *Client) TraceConsolePort(consolePortID int) ([]TraceHop, error) {
+	return c.TraceConsolePortWithContext(context.Background(), consolePortID)
+}
+
+// TraceConsolePortWithContext traces the cable path starting at a console port using the provided context
+func (c *Client) TraceConsolePortWithContext(ctx context.Context, consolePortID int) ([]TraceHop, error) {
+	return c.trace(ctx, "console-ports", consolePortID)
+}
+
+// TraceConsoleServerPort traces the cable path starting at a console server port, returning each
+// segment in order from the console server port to the far endpoint
+func (c *Client) TraceConsoleServerPort(consoleServerPortID int) ([]TraceHop, error) {
+	return c.TraceConsoleServerPortWithContext(context.Background(), consoleServerPortID)
+}
+
+// TraceConsoleServerPortWithContext traces the cable path starting at a console server port using the provided context
+func (c *Client) TraceConsoleServerPortWithContext(ctx context.Context, consoleServerPortID int) ([]TraceHop, error) {
+	return c.trace(ctx, "console-server-ports", consoleServerPortID)
+}
+
+// TracePowerPort traces the cable path starting at a power port, returning each
+// segment in order from the power port to the far endpoint
+func (c *Client) TracePowerPort(powerPortID int) ([]TraceHop, error) {
+	return c.TracePowerPortWithContext(context.Background(), powerPortID)
+}
+
+// TracePowerPortWithContext traces the cable path starting at a power port using the provided context
+func (c *Client) TracePowerPortWithContext(ctx context.Context, powerPortID int) ([]TraceHop, error) {
+	return c.trace(ctx, "power-ports", powerPortID)
+}
+
+// TracePowerOutlet traces the cable path starting at a power outlet, returning each
+// segment in order from the power outlet to the far endpoint
+func (c *Client) TracePowerOutlet(powerOutletID int) ([]TraceHop, error) {
+	return c.TracePowerOutletWithContext(context.Background(), powerOutletID)
+}
+
+// TracePowerOutletWithContext traces the cable path starting at a power outlet using the provided context
+func (c *Client) TracePowerOutletWithContext(ctx context.Context, powerOutletID int) ([]TraceHop, error) {
+	return c.trace(ctx, "power-outlets", powerOutletID)
+}
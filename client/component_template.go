@@ -0,0 +1,95 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// ComponentTemplate holds the fields shared by every component template.
+// A template belongs to either a device type or a module type, and is
+// replicated onto each device or module created from it.
+type ComponentTemplate struct {
+	ID          int         `json:"id"`
+	URL         string      `json:"url"`
+	Display     string      `json:"display"`
+	DeviceType  *DeviceType `json:"device_type,omitempty"`
+	ModuleType  *ModuleType `json:"module_type,omitempty"`
+	Name        string      `json:"name"`
+	Label       string      `json:"label,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Created     string      `json:"created"`
+	LastUpdated string      `json:"last_updated"`
+}
+
+// ComponentTemplateInput holds the writable fields shared by every component
+// template. Exactly one of DeviceType and ModuleType must be set.
+type ComponentTemplateInput struct {
+	DeviceType  int    `json:"device_type,omitempty"`
+	ModuleType  int    `json:"module_type,omitempty"`
+	Name        string `json:"name"`
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// validate checks the parent and name of a component template
+func (input *ComponentTemplateInput) validate() models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if input.DeviceType == 0 && input.ModuleType == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device_type",
+			Message: "Either device type or module type is required",
+		})
+	}
+
+	if input.DeviceType != 0 && input.ModuleType != 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "module_type",
+			Message: "cannot be set together with device type",
+		})
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	return errors
+}
+
+// PatchComponentTemplateInput holds the patchable fields shared by every component template
+type PatchComponentTemplateInput struct {
+	DeviceType  *int    `json:"device_type,omitempty"`
+	ModuleType  *int    `json:"module_type,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// validate checks the parent and name of a component template patch
+func (input *PatchComponentTemplateInput) validate() models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if input.DeviceType != nil && input.ModuleType != nil && *input.DeviceType != 0 && *input.ModuleType != 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "module_type",
+			Message: "cannot be set together with device type",
+		})
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	return errors
+}
+
+// ListComponentTemplatesInput represents the input for listing component templates of any kind
+type ListComponentTemplatesInput struct {
+	Query        string   `query:"q"`              // General search
+	Name         []string `query:"name"`           // Filter by exact name
+	DeviceTypeID []int    `query:"device_type_id"` // Filter by device type ID
+	ModuleTypeID []int    `query:"module_type_id"` // Filter by module type ID
+	Limit        int      `query:"limit"`          // Number of results to return per page
+	Offset       int      `query:"offset"`         // The initial index from which to return the results
+}
@@ -0,0 +1,81 @@
+package client
+
+// InterfaceTemplateResource is the typed resource for dcim/interface-templates
+type InterfaceTemplateResource = Resource[InterfaceTemplate, CreateInterfaceTemplateInput, UpdateInterfaceTemplateInput, PatchInterfaceTemplateInput, ListComponentTemplatesInput]
+
+// InterfaceTemplates returns the typed resource for dcim/interface-templates
+func (c *Client) InterfaceTemplates() *InterfaceTemplateResource {
+	return NewResource[InterfaceTemplate, CreateInterfaceTemplateInput, UpdateInterfaceTemplateInput, PatchInterfaceTemplateInput, ListComponentTemplatesInput](c, "dcim", "interface-templates")
+}
+
+// ConsolePortTemplateResource is the typed resource for dcim/console-port-templates
+type ConsolePortTemplateResource = Resource[ConsolePortTemplate, CreateConsolePortTemplateInput, UpdateConsolePortTemplateInput, PatchConsolePortTemplateInput, ListComponentTemplatesInput]
+
+// ConsolePortTemplates returns the typed resource for dcim/console-port-templates
+func (c *Client) ConsolePortTemplates() *ConsolePortTemplateResource {
+	return NewResource[ConsolePortTemplate, CreateConsolePortTemplateInput, UpdateConsolePortTemplateInput, PatchConsolePortTemplateInput, ListComponentTemplatesInput](c, "dcim", "console-port-templates")
+}
+
+// PowerPortTemplateResource is the typed resource for dcim/power-port-templates
+type PowerPortTemplateResource = Resource[PowerPortTemplate, CreatePowerPortTemplateInput, UpdatePowerPortTemplateInput, PatchPowerPortTemplateInput, ListComponentTemplatesInput]
+
+// PowerPortTemplates returns the typed resource for dcim/power-port-templates
+func (c *Client) PowerPortTemplates() *PowerPortTemplateResource {
+	return NewResource[PowerPortTemplate, CreatePowerPortTemplateInput, UpdatePowerPortTemplateInput, PatchPowerPortTemplateInput, ListComponentTemplatesInput](c, "dcim", "power-port-templates")
+}
+
+// FrontPortTemplateResource is the typed resource for dcim/front-port-templates
+type FrontPortTemplateResource = Resource[FrontPortTemplate, CreateFrontPortTemplateInput, UpdateFrontPortTemplateInput, PatchFrontPortTemplateInput, ListComponentTemplatesInput]
+
+// FrontPortTemplates returns the typed resource for dcim/front-port-templates
+func (c *Client) FrontPortTemplates() *FrontPortTemplateResource {
+	return NewResource[FrontPortTemplate, CreateFrontPortTemplateInput, UpdateFrontPortTemplateInput, PatchFrontPortTemplateInput, ListComponentTemplatesInput](c, "dcim", "front-port-templates")
+}
+
+// RearPortTemplateResource is the typed resource for dcim/rear-port-templates
+type RearPortTemplateResource = Resource[RearPortTemplate, CreateRearPortTemplateInput, UpdateRearPortTemplateInput, PatchRearPortTemplateInput, ListComponentTemplatesInput]
+
+// RearPortTemplates returns the typed resource for dcim/rear-port-templates
+func (c *Client) RearPortTemplates() *RearPortTemplateResource {
+	return NewResource[RearPortTemplate, CreateRearPortTemplateInput, UpdateRearPortTemplateInput, PatchRearPortTemplateInput, ListComponentTemplatesInput](c, "dcim", "rear-port-templates")
+}
+
+// ModuleBayTemplateResource is the typed resource for dcim/module-bay-templates
+type ModuleBayTemplateResource = Resource[ModuleBayTemplate, CreateModuleBayTemplateInput, UpdateModuleBayTemplateInput, PatchModuleBayTemplateInput, ListComponentTemplatesInput]
+
+// ModuleBayTemplates returns the typed resource for dcim/module-bay-templates
+func (c *Client) ModuleBayTemplates() *ModuleBayTemplateResource {
+	return NewResource[ModuleBayTemplate, CreateModuleBayTemplateInput, UpdateModuleBayTemplateInput, PatchModuleBayTemplateInput, ListComponentTemplatesInput](c, "dcim", "module-bay-templates")
+}
+
+// ConsoleServerPortTemplateResource is the typed resource for dcim/console-server-port-templates
+type ConsoleServerPortTemplateResource = Resource[ConsoleServerPortTemplate, CreateConsoleServerPortTemplateInput, UpdateConsoleServerPortTemplateInput, PatchConsoleServerPortTemplateInput, ListComponentTemplatesInput]
+
+// ConsoleServerPortTemplates returns the typed resource for dcim/console-server-port-templates
+func (c *Client) ConsoleServerPortTemplates() *ConsoleServerPortTemplateResource {
+	return NewResource[ConsoleServerPortTemplate, CreateConsoleServerPortTemplateInput, UpdateConsoleServerPortTemplateInput, PatchConsoleServerPortTemplateInput, ListComponentTemplatesInput](c, "dcim", "console-server-port-templates")
+}
+
+// PowerOutletTemplateResource is the typed resource for dcim/power-outlet-templates
+type PowerOutletTemplateResource = Resource[PowerOutletTemplate, CreatePowerOutletTemplateInput, UpdatePowerOutletTemplateInput, PatchPowerOutletTemplateInput, ListComponentTemplatesInput]
+
+// PowerOutletTemplates returns the typed resource for dcim/power-outlet-templates
+func (c *Client) PowerOutletTemplates() *PowerOutletTemplateResource {
+	return NewResource[PowerOutletTemplate, CreatePowerOutletTemplateInput, UpdatePowerOutletTemplateInput, PatchPowerOutletTemplateInput, ListComponentTemplatesInput](c, "dcim", "power-outlet-templates")
+}
+
+// DeviceBayTemplateResource is the typed resource for dcim/device-bay-templates
+type DeviceBayTemplateResource = Resource[DeviceBayTemplate, CreateDeviceBayTemplateInput, UpdateDeviceBayTemplateInput, PatchDeviceBayTemplateInput, ListComponentTemplatesInput]
+
+// DeviceBayTemplates returns the typed resource for dcim/device-bay-templates
+func (c *Client) DeviceBayTemplates() *DeviceBayTemplateResource {
+	return NewResource[DeviceBayTemplate, CreateDeviceBayTemplateInput, UpdateDeviceBayTemplateInput, PatchDeviceBayTemplateInput, ListComponentTemplatesInput](c, "dcim", "device-bay-templates")
+}
+
+// InventoryItemTemplateResource is the typed resource for dcim/inventory-item-templates
+type InventoryItemTemplateResource = Resource[InventoryItemTemplate, CreateInventoryItemTemplateInput, UpdateInventoryItemTemplateInput, PatchInventoryItemTemplateInput, ListComponentTemplatesInput]
+
+// InventoryItemTemplates returns the typed resource for dcim/inventory-item-templates
+func (c *Client) InventoryItemTemplates() *InventoryItemTemplateResource {
+	return NewResource[InventoryItemTemplate, CreateInventoryItemTemplateInput, UpdateInventoryItemTemplateInput, PatchInventoryItemTemplateInput, ListComponentTemplatesInput](c, "dcim", "inventory-item-templates")
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentTemplateInputEncoding(t *testing.T) {
+	data, err := json.Marshal(&CreateFrontPortTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{DeviceType: 3, Name: "front1"},
+		Type:                   "lc",
+		RearPort:               9,
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"device_type": 3, "name": "front1", "type": "lc", "rear_port": 9}`, string(data))
+}
+
+func TestListComponentTemplates(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/interface-templates/", r.URL.Path)
+		assert.Equal(t, "3", r.URL.Query().Get("device_type_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 1, "results": [{"id": 5, "name": "eth0", "device_type": {"id": 3, "model": "AS-48"}, "type": {"value": "1000base-t"}, "mgmt_only": true}]}`))
+	})
+
+	client := newTestClient(t, ts)
+
+	templates, err := client.InterfaceTemplates().List(context.Background(), &ListComponentTemplatesInput{DeviceTypeID: []int{3}})
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "eth0", templates[0].Name)
+	assert.Equal(t, "AS-48", templates[0].DeviceType.Model)
+	assert.Equal(t, "1000base-t", templates[0].Type.Value)
+	assert.True(t, templates[0].MgmtOnly)
+}
+
+func TestComponentTemplateInputValidate(t *testing.T) {
+	assert.NoError(t, (&CreateInterfaceTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{ModuleType: 1, Name: "eth{module}"},
+		Type:                   InterfaceType10GESFPP,
+	}).Validate())
+
+	assert.Error(t, (&CreateInterfaceTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{Name: "eth0"},
+		Type:                   InterfaceType1000BaseT,
+	}).Validate())
+
+	assert.Error(t, (&CreateConsolePortTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{DeviceType: 1, ModuleType: 2, Name: "con0"},
+	}).Validate())
+
+	assert.Error(t, (&CreateDeviceBayTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{ModuleType: 2, Name: "bay1"},
+	}).Validate())
+
+	maximum, allocated := 100, 150
+	assert.Error(t, (&CreatePowerPortTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{DeviceType: 1, Name: "PSU1"},
+		MaximumDraw:            &maximum,
+		AllocatedDraw:          &allocated,
+	}).Validate())
+
+	assert.Error(t, (&CreatePowerOutletTemplateInput{
+		ComponentTemplateInput: ComponentTemplateInput{DeviceType: 1, Name: "out1"},
+		FeedLeg:                "D",
+	}).Validate())
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListConsolePortsConnectionState(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/console-ports/", r.URL.Path)
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["device_id"])
+		assert.Equal(t, "true", r.URL.Query().Get("cabled"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 2, "results": [
+			{
+				"id": 10, "name": "con0", "device": {"id": 1, "name": "sw1"},
+				"type": {"value": "rj-45"}, "speed": {"value": 9600, "label": "9600 bps"},
+				"cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "console-1"}, "cable_end": "A", "_occupied": true,
+				"link_peers": [{"id": 4, "name": "ttyS4", "device": {"id": 9, "name": "cs1"},
+					"cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "console-1"}, "_occupied": true}],
+				"link_peers_type": "dcim.consoleserverport",
+				"connected_endpoints": [{"id": 4, "name": "ttyS4", "device": {"id": 9, "name": "cs1"},
+					"cable": {"id": 30, "url": "http://netbox/api/dcim/cables/30/", "display": "console-1"}, "_occupied": true}],
+				"connected_endpoints_type": "dcim.consoleserverport",
+				"connected_endpoints_reachable": true
+			},
+			{"id": 11, "name": "con1", "device": {"id": 2, "name": "sw2"}, "mark_connected": true}
+		]}`))
+	})
+
+	client := newTestClient(t, ts)
+
+	cabled := true
+	ports, err := client.ConsolePorts().List(context.Background(), &ListComponentsInput{DeviceID: []int{1, 2}, Cabled: &cabled})
+	require.NoError(t, err)
+	require.Len(t, ports, 2)
+
+	assert.Equal(t, "sw1", ports[0].Device.Name)
+	assert.Equal(t, 9600, ports[0].Speed.Value)
+	assert.True(t, ports[0].IsCabled())
+	assert.True(t, ports[0].IsConnected())
+	assert.Equal(t, 30, ports[0].Cable.ID)
+	assert.Equal(t, "console-1", ports[0].Cable.Display)
+	assert.Equal(t, "cs1", ports[0].ConnectedEndpoints[0].Device.Name)
+	assert.Equal(t, 30, ports[0].LinkPeers[0].Cable.ID)
+
+	assert.True(t, ports[1].IsCabled())
+	assert.False(t, ports[1].IsConnected())
+}
+
+func TestTracePowerPort(t *testing.T) {
+	client := newMockClient(t, "/api/dcim/power-ports/5/trace/", `[[
+		[{"id": 5, "url": "http://netbox/api/dcim/power-ports/5/", "name": "PSU1"}],
+		{"id": 40, "url": "http://netbox/api/dcim/cables/40/"},
+		[{"id": 8, "url": "http://netbox/api/dcim/power-outlets/8/", "name": "out8"}]
+	]]`, http.StatusOK)
+
+	hops, err := client.TracePowerPort(5)
+	require.NoError(t, err)
+	require.Len(t, hops, 1)
+	assert.Equal(t, TerminationTypePowerPort, hops[0].NearEnds[0].ObjectType)
+	assert.Equal(t, TerminationTypePowerOutlet, hops[0].FarEnds[0].ObjectType)
+}
+
+func TestComponentInputValidate(t *testing.T) {
+	assert.NoError(t, (&CreateFrontPortInput{
+		ComponentInput:   ComponentInput{Device: 1, Name: "front1"},
+		Type:             "lc",
+		RearPort:         2,
+		RearPortPosition: 1,
+	}).Validate())
+
+	assert.Error(t, (&CreateConsolePortInput{ComponentInput: ComponentInput{Name: "con0"}}).Validate())
+	assert.Error(t, (&CreateFrontPortInput{ComponentInput: ComponentInput{Device: 1, Name: "front1"}, Type: "lc"}).Validate())
+	assert.Error(t, (&CreateDeviceBayInput{ComponentInput: ComponentInput{Device: 1, Module: 3, Name: "bay1"}}).Validate())
+	assert.Error(t, (&CreatePowerOutletInput{ComponentInput: ComponentInput{Device: 1, Name: "out1"}, FeedLeg: "D"}).Validate())
+}
@@ -0,0 +1,103 @@
+package client
+
+// ConsolePort represents a Netbox console port on a device
+type ConsolePort struct {
+	Component
+	CableState
+	ConnectionState
+	Type  *Choice    `json:"type,omitempty"`
+	Speed *IntChoice `json:"speed,omitempty"`
+}
+
+// CreateConsolePortInput represents the input for creating a console port
+type CreateConsolePortInput struct {
+	ComponentInput
+	Type          string `json:"type,omitempty"`
+	Speed         *int   `json:"speed,omitempty"`
+	MarkConnected bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreateConsolePortInput
+func (input *CreateConsolePortInput) Validate() error {
+	if errors := input.ComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateConsolePortInput represents the input for updating a console port
+type UpdateConsolePortInput CreateConsolePortInput
+
+// Validate validates the UpdateConsolePortInput
+func (input *UpdateConsolePortInput) Validate() error {
+	return (*CreateConsolePortInput)(input).Validate()
+}
+
+// PatchConsolePortInput represents the input for patching a console port
+type PatchConsolePortInput struct {
+	PatchComponentInput
+	Type          *string `json:"type,omitempty"`
+	Speed         *int    `json:"speed,omitempty"`
+	MarkConnected *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchConsolePortInput
+func (input *PatchConsolePortInput) Validate() error {
+	if errors := input.PatchComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ConsoleServerPort represents a Netbox console server port on a device
+type ConsoleServerPort struct {
+	Component
+	CableState
+	ConnectionState
+	Type  *Choice    `json:"type,omitempty"`
+	Speed *IntChoice `json:"speed,omitempty"`
+}
+
+// CreateConsoleServerPortInput represents the input for creating a console server port
+type CreateConsoleServerPortInput struct {
+	ComponentInput
+	Type          string `json:"type,omitempty"`
+	Speed         *int   `json:"speed,omitempty"`
+	MarkConnected bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreateConsoleServerPortInput
+func (input *CreateConsoleServerPortInput) Validate() error {
+	if errors := input.ComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateConsoleServerPortInput represents the input for updating a console server port
+type UpdateConsoleServerPortInput CreateConsoleServerPortInput
+
+// Validate validates the UpdateConsoleServerPortInput
+func (input *UpdateConsoleServerPortInput) Validate() error {
+	return (*CreateConsoleServerPortInput)(input).Validate()
+}
+
+// PatchConsoleServerPortInput represents the input for patching a console server port
+type PatchConsoleServerPortInput struct {
+	PatchComponentInput
+	Type          *string `json:"type,omitempty"`
+	Speed         *int    `json:"speed,omitempty"`
+	MarkConnected *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchConsoleServerPortInput
+func (input *PatchConsoleServerPortInput) Validate() error {
+	if errors := input.PatchComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package client
+
+// ConsolePortTemplate represents a Netbox console port template
+type ConsolePortTemplate struct {
+	ComponentTemplate
+	Type *Choice `json:"type,omitempty"`
+}
+
+// CreateConsolePortTemplateInput represents the input for creating a console port template
+type CreateConsolePortTemplateInput struct {
+	ComponentTemplateInput
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates the CreateConsolePortTemplateInput
+func (input *CreateConsolePortTemplateInput) Validate() error {
+	if errors := input.ComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateConsolePortTemplateInput represents the input for updating a console port template
+type UpdateConsolePortTemplateInput CreateConsolePortTemplateInput
+
+// Validate validates the UpdateConsolePortTemplateInput
+func (input *UpdateConsolePortTemplateInput) Validate() error {
+	return (*CreateConsolePortTemplateInput)(input).Validate()
+}
+
+// PatchConsolePortTemplateInput represents the input for patching a console port template
+type PatchConsolePortTemplateInput struct {
+	PatchComponentTemplateInput
+	Type *string `json:"type,omitempty"`
+}
+
+// Validate validates the PatchConsolePortTemplateInput
+func (input *PatchConsolePortTemplateInput) Validate() error {
+	if errors := input.PatchComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
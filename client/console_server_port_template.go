@@ -0,0 +1,45 @@
+package client
+
+// ConsoleServerPortTemplate represents a Netbox console server port template
+type ConsoleServerPortTemplate struct {
+	ComponentTemplate
+	Type *Choice `json:"type,omitempty"`
+}
+
+// CreateConsoleServerPortTemplateInput represents the input for creating a console server port template
+type CreateConsoleServerPortTemplateInput struct {
+	ComponentTemplateInput
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates the CreateConsoleServerPortTemplateInput
+func (input *CreateConsoleServerPortTemplateInput) Validate() error {
+	if errors := input.ComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateConsoleServerPortTemplateInput represents the input for updating a console server port template
+type UpdateConsoleServerPortTemplateInput CreateConsoleServerPortTemplateInput
+
+// Validate validates the UpdateConsoleServerPortTemplateInput
+func (input *UpdateConsoleServerPortTemplateInput) Validate() error {
+	return (*CreateConsoleServerPortTemplateInput)(input).Validate()
+}
+
+// PatchConsoleServerPortTemplateInput represents the input for patching a console server port template
+type PatchConsoleServerPortTemplateInput struct {
+	PatchComponentTemplateInput
+	Type *string `json:"type,omitempty"`
+}
+
+// Validate validates the PatchConsoleServerPortTemplateInput
+func (input *PatchConsoleServerPortTemplateInput) Validate() error {
+	if errors := input.PatchComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
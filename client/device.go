@@ -0,0 +1,284 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for devices
+const (
+	DeviceStatusOffline         = "offline"
+	DeviceStatusActive          = "active"
+	DeviceStatusPlanned         = "planned"
+	DeviceStatusStaged          = "staged"
+	DeviceStatusFailed          = "failed"
+	DeviceStatusInventory       = "inventory"
+	DeviceStatusDecommissioning = "decommissioning"
+)
+
+// deviceStatuses lists all valid device status values
+var deviceStatuses = []string{
+	DeviceStatusOffline,
+	DeviceStatusActive,
+	DeviceStatusPlanned,
+	DeviceStatusStaged,
+	DeviceStatusFailed,
+	DeviceStatusInventory,
+	DeviceStatusDecommissioning,
+}
+
+// Valid rack faces
+const (
+	RackFaceFront = "front"
+	RackFaceRear  = "rear"
+)
+
+// Device represents a Netbox device
+type Device struct {
+	ID                     int            `json:"id"`
+	URL                    string         `json:"url"`
+	Display                string         `json:"display"`
+	Name                   string         `json:"name"`
+	DeviceType             *NestedObject  `json:"device_type"`
+	Role                   *NestedObject  `json:"role"`
+	Tenant                 *NestedObject  `json:"tenant,omitempty"`
+	Platform               *NestedObject  `json:"platform,omitempty"`
+	Serial                 string         `json:"serial,omitempty"`
+	AssetTag               *string        `json:"asset_tag,omitempty"`
+	Site                   *NestedObject  `json:"site"`
+	Location               *NestedObject  `json:"location,omitempty"`
+	Rack                   *NestedObject  `json:"rack,omitempty"`
+	Position               *float64       `json:"position,omitempty"`
+	Face                   *Choice        `json:"face,omitempty"`
+	Latitude               *float64       `json:"latitude,omitempty"`
+	Longitude              *float64       `json:"longitude,omitempty"`
+	ParentDevice           *NestedObject  `json:"parent_device,omitempty"`
+	Status                 *Status        `json:"status"`
+	Airflow                *Choice        `json:"airflow,omitempty"`
+	PrimaryIP              *NestedObject  `json:"primary_ip,omitempty"`
+	PrimaryIP4             *NestedObject  `json:"primary_ip4,omitempty"`
+	PrimaryIP6             *NestedObject  `json:"primary_ip6,omitempty"`
+	OOBIP                  *NestedObject  `json:"oob_ip,omitempty"`
+	Cluster                *NestedObject  `json:"cluster,omitempty"`
+	VirtualChassis         *NestedObject  `json:"virtual_chassis,omitempty"`
+	VCPosition             *int           `json:"vc_position,omitempty"`
+	VCPriority             *int           `json:"vc_priority,omitempty"`
+	Description            string         `json:"description,omitempty"`
+	Comments               string         `json:"comments,omitempty"`
+	ConfigTemplate         *NestedObject  `json:"config_template,omitempty"`
+	LocalContextData       map[string]any `json:"local_context_data,omitempty"`
+	Tags                   []models.Tag   `json:"tags,omitempty"`
+	CustomFields           map[string]any `json:"custom_fields,omitempty"`
+	Created                string         `json:"created"`
+	LastUpdated            string         `json:"last_updated"`
+	ConsolePortCount       int            `json:"console_port_count"`
+	ConsoleServerPortCount int            `json:"console_server_port_count"`
+	PowerPortCount         int            `json:"power_port_count"`
+	PowerOutletCount       int            `json:"power_outlet_count"`
+	InterfaceCount         int            `json:"interface_count"`
+	FrontPortCount         int            `json:"front_port_count"`
+	RearPortCount          int            `json:"rear_port_count"`
+	DeviceBayCount         int            `json:"device_bay_count"`
+	ModuleBayCount         int            `json:"module_bay_count"`
+	InventoryItemCount     int            `json:"inventory_item_count"`
+}
+
+// CreateDeviceInput represents the input for creating a device
+type CreateDeviceInput struct {
+	Name             string             `json:"name,omitempty"`
+	DeviceType       int                `json:"device_type"`
+	Role             int                `json:"role"`
+	Site             int                `json:"site"`
+	Tenant           int                `json:"tenant,omitempty"`
+	Platform         int                `json:"platform,omitempty"`
+	Serial           string             `json:"serial,omitempty"`
+	AssetTag         string             `json:"asset_tag,omitempty"`
+	Location         int                `json:"location,omitempty"`
+	Rack             int                `json:"rack,omitempty"`
+	Position         *float64           `json:"position,omitempty"`
+	Face             string             `json:"face,omitempty"`
+	Latitude         *float64           `json:"latitude,omitempty"`
+	Longitude        *float64           `json:"longitude,omitempty"`
+	Status           string             `json:"status,omitempty"`
+	Airflow          string             `json:"airflow,omitempty"`
+	PrimaryIP4       int                `json:"primary_ip4,omitempty"`
+	PrimaryIP6       int                `json:"primary_ip6,omitempty"`
+	OOBIP            int                `json:"oob_ip,omitempty"`
+	Cluster          int                `json:"cluster,omitempty"`
+	VirtualChassis   int                `json:"virtual_chassis,omitempty"`
+	VCPosition       *int               `json:"vc_position,omitempty"`
+	VCPriority       *int               `json:"vc_priority,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	Comments         string             `json:"comments,omitempty"`
+	ConfigTemplate   int                `json:"config_template,omitempty"`
+	LocalContextData map[string]any     `json:"local_context_data,omitempty"`
+	Tags             []models.TagCreate `json:"tags,omitempty"`
+	CustomFields     map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateDeviceInput
+func (input *CreateDeviceInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.DeviceType == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device_type",
+			Message: "Device type is required",
+		})
+	}
+
+	if input.Role == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "role",
+			Message: "Role is required",
+		})
+	}
+
+	if input.Site == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "site",
+			Message: "Site is required",
+		})
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, deviceStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Face != "" {
+		if err := models.ValidateOneOf("face", input.Face, RackFaceFront, RackFaceRear); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Position != nil && (input.Rack == 0 || input.Face == "") {
+		errors = append(errors, models.ValidationError{
+			Field:   "position",
+			Message: "requires rack and face to be set",
+		})
+	}
+
+	if input.Latitude != nil {
+		if err := models.ValidateRange("latitude", *input.Latitude, -90, 90); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Longitude != nil {
+		if err := models.ValidateRange("longitude", *input.Longitude, -180, 180); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateDeviceInput represents the input for updating a device
+type UpdateDeviceInput CreateDeviceInput
+
+// Validate validates the UpdateDeviceInput
+func (input *UpdateDeviceInput) Validate() error {
+	return (*CreateDeviceInput)(input).Validate()
+}
+
+// PatchDeviceInput represents the input for patching a device
+type PatchDeviceInput struct {
+	Name             *string             `json:"name,omitempty"`
+	DeviceType       *int                `json:"device_type,omitempty"`
+	Role             *int                `json:"role,omitempty"`
+	Site             *int                `json:"site,omitempty"`
+	Tenant           *int                `json:"tenant,omitempty"`
+	Platform         *int                `json:"platform,omitempty"`
+	Serial           *string             `json:"serial,omitempty"`
+	AssetTag         *string             `json:"asset_tag,omitempty"`
+	Location         *int                `json:"location,omitempty"`
+	Rack             *int                `json:"rack,omitempty"`
+	Position         *float64            `json:"position,omitempty"`
+	Face             *string             `json:"face,omitempty"`
+	Latitude         *float64            `json:"latitude,omitempty"`
+	Longitude        *float64            `json:"longitude,omitempty"`
+	Status           *string             `json:"status,omitempty"`
+	Airflow          *string             `json:"airflow,omitempty"`
+	PrimaryIP4       *int                `json:"primary_ip4,omitempty"`
+	PrimaryIP6       *int                `json:"primary_ip6,omitempty"`
+	OOBIP            *int                `json:"oob_ip,omitempty"`
+	Cluster          *int                `json:"cluster,omitempty"`
+	VirtualChassis   *int                `json:"virtual_chassis,omitempty"`
+	VCPosition       *int                `json:"vc_position,omitempty"`
+	VCPriority       *int                `json:"vc_priority,omitempty"`
+	Description      *string             `json:"description,omitempty"`
+	Comments         *string             `json:"comments,omitempty"`
+	ConfigTemplate   *int                `json:"config_template,omitempty"`
+	LocalContextData map[string]any      `json:"local_context_data,omitempty"`
+	Tags             *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields     map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchDeviceInput
+func (input *PatchDeviceInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, deviceStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Face != nil {
+		if err := models.ValidateOneOf("face", *input.Face, RackFaceFront, RackFaceRear); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Latitude != nil {
+		if err := models.ValidateRange("latitude", *input.Latitude, -90, 90); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Longitude != nil {
+		if err := models.ValidateRange("longitude", *input.Longitude, -180, 180); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListDevicesInput represents the input for listing devices. Slice fields
+// match any of the given values.
+type ListDevicesInput struct {
+	Query                string   `query:"q"`                      // General search
+	Name                 string   `query:"name__ic"`               // Filter by name (case-insensitive partial match)
+	Site                 []string `query:"site"`                   // Filter by site slug
+	SiteID               []int    `query:"site_id"`                // Filter by site ID
+	LocationID           []int    `query:"location_id"`            // Filter by location ID, including child locations
+	RackID               []int    `query:"rack_id"`                // Filter by rack ID
+	Role                 []string `query:"role"`                   // Filter by device role slug
+	RoleID               []int    `query:"role_id"`                // Filter by device role ID
+	Platform             []string `query:"platform"`               // Filter by platform slug
+	PlatformID           []int    `query:"platform_id"`            // Filter by platform ID
+	DeviceTypeID         []int    `query:"device_type_id"`         // Filter by device type ID
+	Manufacturer         []string `query:"manufacturer"`           // Filter by manufacturer slug
+	ManufacturerID       []int    `query:"manufacturer_id"`        // Filter by manufacturer ID
+	Serial               []string `query:"serial"`                 // Filter by serial number
+	AssetTag             []string `query:"asset_tag"`              // Filter by asset tag
+	Status               []string `query:"status"`                 // Filter by status
+	Tenant               []string `query:"tenant"`                 // Filter by tenant slug
+	TenantID             []int    `query:"tenant_id"`              // Filter by tenant ID
+	HasPrimaryIP         *bool    `query:"has_primary_ip"`         // Filter by whether a primary IP is assigned
+	VirtualChassisID     []int    `query:"virtual_chassis_id"`     // Filter by virtual chassis ID
+	VirtualChassisMember *bool    `query:"virtual_chassis_member"` // Filter by whether the device belongs to a virtual chassis
+	Tag                  []string `query:"tag"`                    // Filter by tag slug
+	Limit                int      `query:"limit"`                  // Number of results to return per page
+	Offset               int      `query:"offset"`                 // The initial index from which to return the results
+}
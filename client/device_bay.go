@@ -0,0 +1,67 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// DeviceBay represents a Netbox device bay, a slot in a parent device which
+// holds a child device
+type DeviceBay struct {
+	Component
+	InstalledDevice *Device `json:"installed_device,omitempty"`
+}
+
+// CreateDeviceBayInput represents the input for creating a device bay
+type CreateDeviceBayInput struct {
+	ComponentInput
+	InstalledDevice int `json:"installed_device,omitempty"`
+}
+
+// Validate validates the CreateDeviceBayInput
+func (input *CreateDeviceBayInput) Validate() error {
+	errors := input.ComponentInput.validate()
+
+	if input.Module != 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "module",
+			Message: "device bays cannot belong to a module",
+		})
+	}
+
+	if input.InstalledDevice != 0 && input.InstalledDevice == input.Device {
+		errors = append(errors, models.ValidationError{
+			Field:   "installed_device",
+			Message: "a device cannot be installed into itself",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateDeviceBayInput represents the input for updating a device bay
+type UpdateDeviceBayInput CreateDeviceBayInput
+
+// Validate validates the UpdateDeviceBayInput
+func (input *UpdateDeviceBayInput) Validate() error {
+	return (*CreateDeviceBayInput)(input).Validate()
+}
+
+// PatchDeviceBayInput represents the input for patching a device bay. Set
+// InstalledDevice to install or replace a child device.
+type PatchDeviceBayInput struct {
+	PatchComponentInput
+	InstalledDevice *int `json:"installed_device,omitempty"`
+}
+
+// Validate validates the PatchDeviceBayInput
+func (input *PatchDeviceBayInput) Validate() error {
+	if errors := input.PatchComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// DeviceBayTemplate represents a Netbox device bay template. Device bays hold
+// child devices and can only belong to a device type.
+type DeviceBayTemplate struct {
+	ComponentTemplate
+}
+
+// CreateDeviceBayTemplateInput represents the input for creating a device bay template
+type CreateDeviceBayTemplateInput struct {
+	ComponentTemplateInput
+}
+
+// Validate validates the CreateDeviceBayTemplateInput
+func (input *CreateDeviceBayTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+	errors = append(errors, validateDeviceTypeOnly(input.ModuleType)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateDeviceBayTemplateInput represents the input for updating a device bay template
+type UpdateDeviceBayTemplateInput CreateDeviceBayTemplateInput
+
+// Validate validates the UpdateDeviceBayTemplateInput
+func (input *UpdateDeviceBayTemplateInput) Validate() error {
+	return (*CreateDeviceBayTemplateInput)(input).Validate()
+}
+
+// PatchDeviceBayTemplateInput represents the input for patching a device bay template
+type PatchDeviceBayTemplateInput struct {
+	PatchComponentTemplateInput
+}
+
+// Validate validates the PatchDeviceBayTemplateInput
+func (input *PatchDeviceBayTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.ModuleType != nil {
+		errors = append(errors, validateDeviceTypeOnly(*input.ModuleType)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateDeviceTypeOnly rejects a module type on templates which Netbox only
+// supports on device types
+func validateDeviceTypeOnly(moduleType int) models.ValidationErrors {
+	if moduleType != 0 {
+		return models.ValidationErrors{{
+			Field:   "module_type",
+			Message: "is not supported for this template; use a device type",
+		}}
+	}
+
+	return nil
+}
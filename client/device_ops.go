@@ -0,0 +1,9 @@
+package client
+
+// DeviceResource is the typed resource for dcim/devices
+type DeviceResource = Resource[Device, CreateDeviceInput, UpdateDeviceInput, PatchDeviceInput, ListDevicesInput]
+
+// Devices returns the typed resource for dcim/devices
+func (c *Client) Devices() *DeviceResource {
+	return NewResource[Device, CreateDeviceInput, UpdateDeviceInput, PatchDeviceInput, ListDevicesInput](c, "dcim", "devices")
+}
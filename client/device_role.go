@@ -0,0 +1,125 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// DeviceRole represents a Netbox device role, the function a device serves
+// such as "core switch" or "server". Roles with VMRole set may also be
+// assigned to virtual machines.
+type DeviceRole struct {
+	ID                  int            `json:"id"`
+	URL                 string         `json:"url"`
+	Display             string         `json:"display"`
+	Name                string         `json:"name"`
+	Slug                string         `json:"slug"`
+	Color               string         `json:"color"`
+	VMRole              bool           `json:"vm_role"`
+	ConfigTemplate      *NestedObject  `json:"config_template,omitempty"`
+	Description         string         `json:"description,omitempty"`
+	Tags                []models.Tag   `json:"tags,omitempty"`
+	CustomFields        map[string]any `json:"custom_fields,omitempty"`
+	Created             string         `json:"created"`
+	LastUpdated         string         `json:"last_updated"`
+	DeviceCount         int            `json:"device_count"`
+	VirtualMachineCount int            `json:"virtualmachine_count"`
+}
+
+// CreateDeviceRoleInput represents the input for creating a device role
+type CreateDeviceRoleInput struct {
+	Name           string             `json:"name"`
+	Slug           string             `json:"slug"`
+	Color          string             `json:"color,omitempty"`
+	VMRole         *bool              `json:"vm_role,omitempty"`
+	ConfigTemplate int                `json:"config_template,omitempty"`
+	Description    string             `json:"description,omitempty"`
+	Tags           []models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateDeviceRoleInput
+func (input *CreateDeviceRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Color != "" {
+		if err := models.ValidateColor("color", input.Color); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateDeviceRoleInput represents the input for updating a device role
+type UpdateDeviceRoleInput CreateDeviceRoleInput
+
+// Validate validates the UpdateDeviceRoleInput
+func (input *UpdateDeviceRoleInput) Validate() error {
+	return (*CreateDeviceRoleInput)(input).Validate()
+}
+
+// PatchDeviceRoleInput represents the input for patching a device role
+type PatchDeviceRoleInput struct {
+	Name           *string             `json:"name,omitempty"`
+	Slug           *string             `json:"slug,omitempty"`
+	Color          *string             `json:"color,omitempty"`
+	VMRole         *bool               `json:"vm_role,omitempty"`
+	ConfigTemplate *int                `json:"config_template,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Tags           *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchDeviceRoleInput
+func (input *PatchDeviceRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Color != nil {
+		if err := models.ValidateColor("color", *input.Color); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListDeviceRolesInput represents the input for listing device roles
+type ListDeviceRolesInput struct {
+	Query            string   `query:"q"`                  // General search
+	Name             string   `query:"name__ic"`           // Filter by name (case-insensitive partial match)
+	Slug             []string `query:"slug"`               // Filter by slug
+	Color            []string `query:"color"`              // Filter by color
+	VMRole           *bool    `query:"vm_role"`            // Filter by whether the role may be assigned to virtual machines
+	ConfigTemplateID []int    `query:"config_template_id"` // Filter by config template ID
+	Tag              []string `query:"tag"`                // Filter by tag slug
+	Limit            int      `query:"limit"`              // Number of results to return per page
+	Offset           int      `query:"offset"`             // The initial index from which to return the results
+}
@@ -0,0 +1,9 @@
+package client
+
+// DeviceRoleResource is the typed resource for dcim/device-roles
+type DeviceRoleResource = Resource[DeviceRole, CreateDeviceRoleInput, UpdateDeviceRoleInput, PatchDeviceRoleInput, ListDeviceRolesInput]
+
+// DeviceRoles returns the typed resource for dcim/device-roles
+func (c *Client) DeviceRoles() *DeviceRoleResource {
+	return NewResource[DeviceRole, CreateDeviceRoleInput, UpdateDeviceRoleInput, PatchDeviceRoleInput, ListDeviceRolesInput](c, "dcim", "device-roles")
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestDeviceRolesAndPlatforms(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/dcim/device-roles/7/":
+			_, _ = w.Write([]byte(`{"id": 7, "name": "Core Switch", "slug": "core-switch", "color": "2196f3", "vm_role": false, "device_count": 4}`))
+		case "/api/dcim/platforms/":
+			assert.Equal(t, "1", r.URL.Query().Get("manufacturer_id"))
+			_, _ = w.Write([]byte(`{"count": 1, "results": [
+				{"id": 2, "name": "Cisco IOS-XE", "slug": "cisco-iosxe", "manufacturer": {"id": 1, "name": "Cisco", "slug": "cisco"}, "device_count": 4}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+	ctx := context.Background()
+
+	role, err := client.DeviceRoles().Get(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "2196f3", role.Color)
+	assert.False(t, role.VMRole)
+	assert.Equal(t, 4, role.DeviceCount)
+
+	platforms, err := client.Platforms().ListAll(ctx, &ListPlatformsInput{ManufacturerID: []int{1}}, nil)
+	require.NoError(t, err)
+	require.Len(t, platforms, 1)
+	assert.Equal(t, "cisco", platforms[0].Manufacturer.Slug)
+}
+
+func TestDeviceRoleColorValidation(t *testing.T) {
+	client := NewClientForTesting(t)
+
+	_, err := client.DeviceRoles().Create(context.Background(), &CreateDeviceRoleInput{
+		Name:  "Core Switch",
+		Slug:  "core-switch",
+		Color: "#2196f3",
+	})
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+	require.Len(t, validationErrors, 1)
+	assert.Equal(t, "color", validationErrors[0].Field)
+
+	assert.NoError(t, (&PatchRackRoleInput{Color: strPtr("")}).Validate())
+	assert.Error(t, (&PatchRackRoleInput{Color: strPtr("blue")}).Validate())
+}
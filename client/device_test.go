@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestListDevicesFilters(t *testing.T) {
+	hasPrimaryIP := true
+
+	var query url.Values
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/devices/", r.URL.Path)
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 1, "next": null, "previous": null, "results": [{"id": 7, "name": "sw01", "status": {"value": "active", "label": "Active"}}]}`))
+	})
+
+	client := newTestClient(t, ts)
+
+	devices, err := client.Devices().List(context.Background(), &ListDevicesInput{
+		SiteID:       []int{1, 2},
+		Role:         []string{"leaf"},
+		Status:       []string{DeviceStatusActive},
+		Serial:       []string{"ABC123"},
+		HasPrimaryIP: &hasPrimaryIP,
+	})
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, "sw01", devices[0].Name)
+	assert.Equal(t, DeviceStatusActive, devices[0].Status.Value)
+
+	assert.Equal(t, []string{"1", "2"}, query["site_id"])
+	assert.Equal(t, "leaf", query.Get("role"))
+	assert.Equal(t, "active", query.Get("status"))
+	assert.Equal(t, "ABC123", query.Get("serial"))
+	assert.Equal(t, "true", query.Get("has_primary_ip"))
+}
+
+func TestCreateDeviceInputValidate(t *testing.T) {
+	position := 10.0
+
+	err := (&CreateDeviceInput{Status: "bogus", Position: &position}).Validate()
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+
+	var fields []string
+	for _, e := range validationErrors {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"device_type", "role", "site", "status", "position"}, fields)
+
+	assert.NoError(t, (&UpdateDeviceInput{DeviceType: 1, Role: 1, Site: 1}).Validate())
+}
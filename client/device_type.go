@@ -0,0 +1,196 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid subdevice roles for device types
+const (
+	SubdeviceRoleParent = "parent"
+	SubdeviceRoleChild  = "child"
+)
+
+// DeviceType represents a Netbox device type, a hardware model which devices
+// are instances of
+type DeviceType struct {
+	ID                             int            `json:"id"`
+	URL                            string         `json:"url"`
+	Display                        string         `json:"display"`
+	Manufacturer                   *Manufacturer  `json:"manufacturer"`
+	DefaultPlatform                *NestedObject  `json:"default_platform,omitempty"`
+	Model                          string         `json:"model"`
+	Slug                           string         `json:"slug"`
+	PartNumber                     string         `json:"part_number,omitempty"`
+	UHeight                        float64        `json:"u_height"`
+	ExcludeFromUtilization         bool           `json:"exclude_from_utilization"`
+	IsFullDepth                    bool           `json:"is_full_depth"`
+	SubdeviceRole                  *Choice        `json:"subdevice_role,omitempty"`
+	Airflow                        *Choice        `json:"airflow,omitempty"`
+	Weight                         *float64       `json:"weight,omitempty"`
+	WeightUnit                     *Choice        `json:"weight_unit,omitempty"`
+	FrontImage                     *string        `json:"front_image,omitempty"`
+	RearImage                      *string        `json:"rear_image,omitempty"`
+	Description                    string         `json:"description,omitempty"`
+	Comments                       string         `json:"comments,omitempty"`
+	Tags                           []models.Tag   `json:"tags,omitempty"`
+	CustomFields                   map[string]any `json:"custom_fields,omitempty"`
+	Created                        string         `json:"created"`
+	LastUpdated                    string         `json:"last_updated"`
+	DeviceCount                    int            `json:"device_count"`
+	ConsolePortTemplateCount       int            `json:"console_port_template_count"`
+	ConsoleServerPortTemplateCount int            `json:"console_server_port_template_count"`
+	PowerPortTemplateCount         int            `json:"power_port_template_count"`
+	PowerOutletTemplateCount       int            `json:"power_outlet_template_count"`
+	InterfaceTemplateCount         int            `json:"interface_template_count"`
+	FrontPortTemplateCount         int            `json:"front_port_template_count"`
+	RearPortTemplateCount          int            `json:"rear_port_template_count"`
+	DeviceBayTemplateCount         int            `json:"device_bay_template_count"`
+	ModuleBayTemplateCount         int            `json:"module_bay_template_count"`
+	InventoryItemTemplateCount     int            `json:"inventory_item_template_count"`
+}
+
+// CreateDeviceTypeInput represents the input for creating a device type
+type CreateDeviceTypeInput struct {
+	Manufacturer           int                `json:"manufacturer"`
+	DefaultPlatform        int                `json:"default_platform,omitempty"`
+	Model                  string             `json:"model"`
+	Slug                   string             `json:"slug"`
+	PartNumber             string             `json:"part_number,omitempty"`
+	UHeight                *float64           `json:"u_height,omitempty"`
+	ExcludeFromUtilization bool               `json:"exclude_from_utilization,omitempty"`
+	IsFullDepth            *bool              `json:"is_full_depth,omitempty"`
+	SubdeviceRole          string             `json:"subdevice_role,omitempty"`
+	Airflow                string             `json:"airflow,omitempty"`
+	Weight                 *float64           `json:"weight,omitempty"`
+	WeightUnit             string             `json:"weight_unit,omitempty"`
+	Description            string             `json:"description,omitempty"`
+	Comments               string             `json:"comments,omitempty"`
+	Tags                   []models.TagCreate `json:"tags,omitempty"`
+	CustomFields           map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateDeviceTypeInput
+func (input *CreateDeviceTypeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Manufacturer == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "manufacturer",
+			Message: "Manufacturer is required",
+		})
+	}
+
+	if err := models.ValidateRequired("model", input.Model); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validateDeviceTypeFields(input.UHeight, input.SubdeviceRole)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateDeviceTypeInput represents the input for updating a device type
+type UpdateDeviceTypeInput CreateDeviceTypeInput
+
+// Validate validates the UpdateDeviceTypeInput
+func (input *UpdateDeviceTypeInput) Validate() error {
+	return (*CreateDeviceTypeInput)(input).Validate()
+}
+
+// PatchDeviceTypeInput represents the input for patching a device type
+type PatchDeviceTypeInput struct {
+	Manufacturer           *int                `json:"manufacturer,omitempty"`
+	DefaultPlatform        *int                `json:"default_platform,omitempty"`
+	Model                  *string             `json:"model,omitempty"`
+	Slug                   *string             `json:"slug,omitempty"`
+	PartNumber             *string             `json:"part_number,omitempty"`
+	UHeight                *float64            `json:"u_height,omitempty"`
+	ExcludeFromUtilization *bool               `json:"exclude_from_utilization,omitempty"`
+	IsFullDepth            *bool               `json:"is_full_depth,omitempty"`
+	SubdeviceRole          *string             `json:"subdevice_role,omitempty"`
+	Airflow                *string             `json:"airflow,omitempty"`
+	Weight                 *float64            `json:"weight,omitempty"`
+	WeightUnit             *string             `json:"weight_unit,omitempty"`
+	Description            *string             `json:"description,omitempty"`
+	Comments               *string             `json:"comments,omitempty"`
+	Tags                   *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields           map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchDeviceTypeInput
+func (input *PatchDeviceTypeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Model != nil {
+		if err := models.ValidateRequired("model", *input.Model); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	var subdeviceRole string
+	if input.SubdeviceRole != nil {
+		subdeviceRole = *input.SubdeviceRole
+	}
+	errors = append(errors, validateDeviceTypeFields(input.UHeight, subdeviceRole)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateDeviceTypeFields checks the height and subdevice role of a device
+// type. Devices may be a half unit high; zero height is allowed for devices
+// which do not occupy rack space. Empty values are skipped.
+func validateDeviceTypeFields(uHeight *float64, subdeviceRole string) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if uHeight != nil {
+		if err := models.ValidateRange("u_height", *uHeight, 0, 1000); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		} else if *uHeight*2 != float64(int(*uHeight*2)) {
+			errors = append(errors, models.ValidationError{
+				Field:   "u_height",
+				Message: "must be a multiple of 0.5",
+			})
+		}
+	}
+
+	if subdeviceRole != "" {
+		if err := models.ValidateOneOf("subdevice_role", subdeviceRole, SubdeviceRoleParent, SubdeviceRoleChild); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	return errors
+}
+
+// ListDeviceTypesInput represents the input for listing device types
+type ListDeviceTypesInput struct {
+	Query          string   `query:"q"`               // General search
+	Model          []string `query:"model"`           // Filter by exact model name
+	Slug           []string `query:"slug"`            // Filter by slug
+	PartNumber     []string `query:"part_number"`     // Filter by part number
+	ManufacturerID []int    `query:"manufacturer_id"` // Filter by manufacturer ID
+	Manufacturer   []string `query:"manufacturer"`    // Filter by manufacturer slug
+	SubdeviceRole  []string `query:"subdevice_role"`  // Filter by subdevice role
+	IsFullDepth    *bool    `query:"is_full_depth"`   // Filter by whether the device type is full depth
+	Tag            []string `query:"tag"`             // Filter by tag slug
+	Limit          int      `query:"limit"`           // Number of results to return per page
+	Offset         int      `query:"offset"`          // The initial index from which to return the results
+}
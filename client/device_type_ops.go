@@ -0,0 +1,17 @@
+package client
+
+// DeviceTypeResource is the typed resource for dcim/device-types
+type DeviceTypeResource = Resource[DeviceType, CreateDeviceTypeInput, UpdateDeviceTypeInput, PatchDeviceTypeInput, ListDeviceTypesInput]
+
+// DeviceTypes returns the typed resource for dcim/device-types
+func (c *Client) DeviceTypes() *DeviceTypeResource {
+	return NewResource[DeviceType, CreateDeviceTypeInput, UpdateDeviceTypeInput, PatchDeviceTypeInput, ListDeviceTypesInput](c, "dcim", "device-types")
+}
+
+// ModuleTypeResource is the typed resource for dcim/module-types
+type ModuleTypeResource = Resource[ModuleType, CreateModuleTypeInput, UpdateModuleTypeInput, PatchModuleTypeInput, ListModuleTypesInput]
+
+// ModuleTypes returns the typed resource for dcim/module-types
+func (c *Client) ModuleTypes() *ModuleTypeResource {
+	return NewResource[ModuleType, CreateModuleTypeInput, UpdateModuleTypeInput, PatchModuleTypeInput, ListModuleTypesInput](c, "dcim", "module-types")
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Sentinel errors that can be matched against an *APIError with errors.Is
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrConflict         = errors.New("conflict")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrValidation       = errors.New("validation failed")
+)
+
+// APIError represents an error response returned by the Netbox API
+type APIError struct {
+	StatusCode  int                 // HTTP status code of the response
+	Method      string              // HTTP method of the request
+	Path        string              // Path of the request
+	Body        []byte              // Raw response body
+	FieldErrors map[string][]string // Per-field error messages, keyed by field name
+	Detail      string              // Netbox's "detail" message, if any
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: unexpected status code: %d", e.Method, e.Path, e.StatusCode)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field := range e.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		var msgs []string
+		for _, field := range fields {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", field, strings.Join(e.FieldErrors[field], " ")))
+		}
+		msg += ": " + strings.Join(msgs, "; ")
+	}
+
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict || (e.StatusCode == http.StatusBadRequest && e.isUniqueViolation())
+	case ErrPermissionDenied:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest
+	}
+	return false
+}
+
+// isUniqueViolation reports whether Netbox rejected the request because an
+// object with the same unique value already exists
+func (e *APIError) isUniqueViolation() bool {
+	for _, msgs := range e.FieldErrors {
+		for _, msg := range msgs {
+			if strings.Contains(msg, "already exists") || strings.Contains(msg, "must be unique") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether the error is a Netbox 404 response
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether the error is caused by a conflicting or duplicate object
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsPermissionDenied reports whether the error is a Netbox 401 or 403 response
+func IsPermissionDenied(err error) bool {
+	return errors.Is(err, ErrPermissionDenied)
+}
+
+// IsValidation reports whether the error is a Netbox 400 response or a local
+// input validation failure
+func IsValidation(err error) bool {
+	var validationErrors models.ValidationErrors
+	var validationError *models.ValidationError
+	return errors.Is(err, ErrValidation) || errors.As(err, &validationErrors) || errors.As(err, &validationError)
+}
+
+// checkResponse returns an *APIError if the response status code is not one of the expected codes
+func checkResponse(resp *resty.Response, expected ...int) error {
+	for _, code := range expected {
+		if resp.StatusCode() == code {
+			return nil
+		}
+	}
+	return newAPIError(resp)
+}
+
+// newAPIError builds an *APIError from a response
+func newAPIError(resp *resty.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode(),
+		Body:       resp.Body(),
+	}
+
+	if req := resp.Request; req != nil {
+		apiErr.Method = req.Method
+		apiErr.Path = req.URL
+		if req.RawRequest != nil && req.RawRequest.URL != nil {
+			apiErr.Path = req.RawRequest.URL.Path
+		}
+	}
+
+	var body any
+	if err := json.Unmarshal(apiErr.Body, &body); err != nil {
+		return apiErr
+	}
+
+	fieldErrors := map[string][]string{}
+	switch b := body.(type) {
+	case map[string]any:
+		if detail, ok := b["detail"].(string); ok {
+			apiErr.Detail = detail
+			delete(b, "detail")
+		}
+		collectFieldErrors(fieldErrors, "", b)
+	case []any:
+		// Bulk operations return one error object per submitted item
+		for i, item := range b {
+			collectFieldErrors(fieldErrors, fmt.Sprintf("%d", i), item)
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		apiErr.FieldErrors = fieldErrors
+	}
+
+	return apiErr
+}
+
+// collectFieldErrors flattens a Netbox error body into field error messages
+func collectFieldErrors(errs map[string][]string, field string, value any) {
+	switch v := value.(type) {
+	case string:
+		errs[field] = append(errs[field], v)
+	case []any:
+		for _, item := range v {
+			collectFieldErrors(errs, field, item)
+		}
+	case map[string]any:
+		for key, item := range v {
+			name := key
+			if field != "" {
+				name = field + "." + key
+			}
+			collectFieldErrors(errs, name, item)
+		}
+	}
+}
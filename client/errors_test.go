@@ -0,0 +1,83 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		body             string
+		wantNotFound     bool
+		wantConflict     bool
+		wantPermission   bool
+		wantValidation   bool
+		wantDetail       string
+		wantFieldErrors  map[string][]string
+		wantErrorMessage string
+	}{
+		{
+			name:             "not found",
+			status:           http.StatusNotFound,
+			body:             `{"detail": "No Site matches the given query."}`,
+			wantNotFound:     true,
+			wantDetail:       "No Site matches the given query.",
+			wantErrorMessage: "POST /api/dcim/sites/: unexpected status code: 404: No Site matches the given query.",
+		},
+		{
+			name:            "duplicate slug",
+			status:          http.StatusBadRequest,
+			body:            `{"slug": ["site with this slug already exists."]}`,
+			wantConflict:    true,
+			wantValidation:  true,
+			wantFieldErrors: map[string][]string{"slug": {"site with this slug already exists."}},
+		},
+		{
+			name:            "invalid field",
+			status:          http.StatusBadRequest,
+			body:            `{"status": ["\"bogus\" is not a valid choice."], "tags": [{"name": ["This field is required."]}]}`,
+			wantValidation:  true,
+			wantFieldErrors: map[string][]string{"status": {"\"bogus\" is not a valid choice."}, "tags.name": {"This field is required."}},
+		},
+		{
+			name:           "permission denied",
+			status:         http.StatusForbidden,
+			body:           `{"detail": "You do not have permission to perform this action."}`,
+			wantPermission: true,
+			wantDetail:     "You do not have permission to perform this action.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockClient(t, "/api/dcim/sites/", tt.body, tt.status)
+
+			_, err := client.CreateSite(&CreateSiteInput{Name: "Test", Slug: "test", Status: SiteStatusActive})
+			require.Error(t, err)
+
+			var apiErr *APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, http.MethodPost, apiErr.Method)
+			assert.Equal(t, "/api/dcim/sites/", apiErr.Path)
+			assert.Equal(t, tt.wantDetail, apiErr.Detail)
+			assert.Equal(t, tt.wantFieldErrors, apiErr.FieldErrors)
+			assert.NotEmpty(t, apiErr.Body)
+
+			assert.Equal(t, tt.wantNotFound, IsNotFound(err))
+			assert.Equal(t, tt.wantConflict, IsConflict(err))
+			assert.Equal(t, tt.wantPermission, IsPermissionDenied(err))
+			assert.Equal(t, tt.wantValidation, IsValidation(err))
+
+			if tt.wantErrorMessage != "" {
+				assert.Equal(t, tt.wantErrorMessage, err.Error())
+			}
+		})
+	}
+}
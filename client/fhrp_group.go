@@ -0,0 +1,358 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid protocol values for FHRP groups
+const (
+	FHRPProtocolVRRP2     = "vrrp2"
+	FHRPProtocolVRRP3     = "vrrp3"
+	FHRPProtocolHSRP      = "hsrp"
+	FHRPProtocolGLBP      = "glbp"
+	FHRPProtocolCARP      = "carp"
+	FHRPProtocolClusterXL = "clusterxl"
+	FHRPProtocolOther     = "other"
+)
+
+// fhrpProtocols lists all valid FHRP group protocol values
+var fhrpProtocols = []string{
+	FHRPProtocolVRRP2,
+	FHRPProtocolVRRP3,
+	FHRPProtocolHSRP,
+	FHRPProtocolGLBP,
+	FHRPProtocolCARP,
+	FHRPProtocolClusterXL,
+	FHRPProtocolOther,
+}
+
+// Valid authentication types for FHRP groups
+const (
+	FHRPAuthTypePlaintext = "plaintext"
+	FHRPAuthTypeMD5       = "md5"
+)
+
+// fhrpAuthTypes lists all valid FHRP group authentication types
+var fhrpAuthTypes = []string{
+	FHRPAuthTypePlaintext,
+	FHRPAuthTypeMD5,
+}
+
+// FHRPGroup represents a Netbox first-hop redundancy protocol group, such as
+// a VRRP or HSRP group. IPAddresses are the virtual IPs assigned to the group.
+type FHRPGroup struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name,omitempty"`
+	Protocol     *Choice        `json:"protocol"`
+	GroupID      int            `json:"group_id"`
+	AuthType     *Choice        `json:"auth_type,omitempty"`
+	AuthKey      string         `json:"auth_key,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	IPAddresses  []IPAddress    `json:"ip_addresses,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateFHRPGroupInput represents the input for creating an FHRP group
+type CreateFHRPGroupInput struct {
+	Name         string             `json:"name,omitempty"`
+	Protocol     string             `json:"protocol"`
+	GroupID      int                `json:"group_id"`
+	AuthType     string             `json:"auth_type,omitempty"`
+	AuthKey      string             `json:"auth_key,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateFHRPGroupInput
+func (input *CreateFHRPGroupInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateOneOf("protocol", input.Protocol, fhrpProtocols...); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateRange("group_id", float64(input.GroupID), 0, 32767); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.AuthType != "" {
+		if err := models.ValidateOneOf("auth_type", input.AuthType, fhrpAuthTypes...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if err := models.ValidateMaxLength("auth_key", input.AuthKey, 255); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateFHRPGroupInput represents the input for updating an FHRP group
+type UpdateFHRPGroupInput CreateFHRPGroupInput
+
+// Validate validates the UpdateFHRPGroupInput
+func (input *UpdateFHRPGroupInput) Validate() error {
+	return (*CreateFHRPGroupInput)(input).Validate()
+}
+
+// PatchFHRPGroupInput represents the input for patching an FHRP group
+type PatchFHRPGroupInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Protocol     *string             `json:"protocol,omitempty"`
+	GroupID      *int                `json:"group_id,omitempty"`
+	AuthType     *string             `json:"auth_type,omitempty"`
+	AuthKey      *string             `json:"auth_key,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchFHRPGroupInput
+func (input *PatchFHRPGroupInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Protocol != nil {
+		if err := models.ValidateOneOf("protocol", *input.Protocol, fhrpProtocols...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.GroupID != nil {
+		if err := models.ValidateRange("group_id", float64(*input.GroupID), 0, 32767); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.AuthType != nil && *input.AuthType != "" {
+		if err := models.ValidateOneOf("auth_type", *input.AuthType, fhrpAuthTypes...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.AuthKey != nil {
+		if err := models.ValidateMaxLength("auth_key", *input.AuthKey, 255); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListFHRPGroupsInput represents the input for listing FHRP groups
+type ListFHRPGroupsInput struct {
+	Query     string   `query:"q"`          // General search
+	Name      []string `query:"name"`       // Filter by name (exact match)
+	Protocol  []string `query:"protocol"`   // Filter by protocol
+	GroupID   []int    `query:"group_id"`   // Filter by protocol group ID
+	AuthType  []string `query:"auth_type"`  // Filter by authentication type
+	RelatedIP []string `query:"related_ip"` // Filter by groups with a virtual IP in the same network as the given address
+	Tag       []string `query:"tag"`        // Filter by tag slug
+	Limit     int      `query:"limit"`      // Number of results to return per page
+	Offset    int      `query:"offset"`     // The initial index from which to return the results
+}
+
+// FHRPGroupAssignment represents the assignment of an FHRP group to a device
+// or virtual machine interface
+type FHRPGroupAssignment struct {
+	ID            int             `json:"id"`
+	URL           string          `json:"url"`
+	Display       string          `json:"display"`
+	Group         *FHRPGroup      `json:"group"`
+	InterfaceType string          `json:"interface_type"`
+	InterfaceID   int             `json:"interface_id"`
+	Interface     *AssignedObject `json:"interface,omitempty"`
+	Priority      int             `json:"priority"`
+	Created       string          `json:"created"`
+	LastUpdated   string          `json:"last_updated"`
+}
+
+// CreateFHRPGroupAssignmentInput represents the input for assigning an FHRP
+// group to an interface. InterfaceType is AssignedObjectTypeInterface or
+// AssignedObjectTypeVMInterface.
+type CreateFHRPGroupAssignmentInput struct {
+	Group         int    `json:"group"`
+	InterfaceType string `json:"interface_type"`
+	InterfaceID   int    `json:"interface_id"`
+	Priority      int    `json:"priority"`
+}
+
+// Validate validates the CreateFHRPGroupAssignmentInput
+func (input *CreateFHRPGroupAssignmentInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Group == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "group",
+			Message: "Group is required",
+		})
+	}
+
+	errors = append(errors, validateFHRPGroupAssignmentFields(input.InterfaceType, input.InterfaceID, input.Priority)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateFHRPGroupAssignmentInput represents the input for updating an FHRP group assignment
+type UpdateFHRPGroupAssignmentInput CreateFHRPGroupAssignmentInput
+
+// Validate validates the UpdateFHRPGroupAssignmentInput
+func (input *UpdateFHRPGroupAssignmentInput) Validate() error {
+	return (*CreateFHRPGroupAssignmentInput)(input).Validate()
+}
+
+// PatchFHRPGroupAssignmentInput represents the input for patching an FHRP group assignment
+type PatchFHRPGroupAssignmentInput struct {
+	Group         *int    `json:"group,omitempty"`
+	InterfaceType *string `json:"interface_type,omitempty"`
+	InterfaceID   *int    `json:"interface_id,omitempty"`
+	Priority      *int    `json:"priority,omitempty"`
+}
+
+// Validate validates the PatchFHRPGroupAssignmentInput
+func (input *PatchFHRPGroupAssignmentInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.InterfaceType != nil {
+		if err := models.ValidateOneOf("interface_type", *input.InterfaceType, AssignedObjectTypeInterface, AssignedObjectTypeVMInterface); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Priority != nil {
+		if err := models.ValidateRange("priority", float64(*input.Priority), 0, 255); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListFHRPGroupAssignmentsInput represents the input for listing FHRP group assignments
+type ListFHRPGroupAssignmentsInput struct {
+	GroupID          []int    `query:"group_id"`           // Filter by FHRP group ID
+	InterfaceType    []string `query:"interface_type"`     // Filter by interface object type
+	InterfaceID      []int    `query:"interface_id"`       // Filter by interface ID
+	Priority         []int    `query:"priority"`           // Filter by priority
+	DeviceID         []int    `query:"device_id"`          // Filter by device ID
+	Device           []string `query:"device"`             // Filter by device name
+	VirtualMachineID []int    `query:"virtual_machine_id"` // Filter by virtual machine ID
+	Limit            int      `query:"limit"`              // Number of results to return per page
+	Offset           int      `query:"offset"`             // The initial index from which to return the results
+}
+
+// CreateFHRPGroupWithAssignmentsInput represents the input for creating an
+// FHRP group together with its virtual IPs and interface assignments. The
+// virtual IPs are assigned to the new group, with the role matching its
+// protocol unless one is set, and the group of each assignment is set to the
+// new group.
+type CreateFHRPGroupWithAssignmentsInput struct {
+	Group       CreateFHRPGroupInput
+	VirtualIPs  []CreateIPAddressInput
+	Assignments []CreateFHRPGroupAssignmentInput
+}
+
+// Validate validates the CreateFHRPGroupWithAssignmentsInput. Errors of the
+// virtual IPs and assignments are reported with their index, e.g.
+// "assignments[1].priority".
+func (input *CreateFHRPGroupWithAssignmentsInput) Validate() error {
+	var errors models.ValidationErrors
+
+	collect := func(prefix string, err error) {
+		nested, _ := err.(models.ValidationErrors)
+		for _, err := range nested {
+			if prefix != "" {
+				err.Field = prefix + "." + err.Field
+			}
+			errors = append(errors, err)
+		}
+	}
+
+	collect("", input.Group.Validate())
+	for i := range input.VirtualIPs {
+		collect(fmt.Sprintf("virtual_ips[%d]", i), input.VirtualIPs[i].Validate())
+	}
+	for i, assignment := range input.Assignments {
+		collect(fmt.Sprintf("assignments[%d]", i), validateFHRPGroupAssignmentFields(assignment.InterfaceType, assignment.InterfaceID, assignment.Priority))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// FHRPGroupWithAssignments is the result of creating an FHRP group with its
+// virtual IPs and interface assignments
+type FHRPGroupWithAssignments struct {
+	Group       *FHRPGroup
+	VirtualIPs  []IPAddress
+	Assignments []FHRPGroupAssignment
+}
+
+// validateFHRPGroupAssignmentFields validates the interface and priority of an FHRP group assignment
+func validateFHRPGroupAssignmentFields(interfaceType string, interfaceID, priority int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateOneOf("interface_type", interfaceType, AssignedObjectTypeInterface, AssignedObjectTypeVMInterface); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if interfaceID == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "interface_id",
+			Message: "Interface ID is required",
+		})
+	}
+
+	if err := models.ValidateRange("priority", float64(priority), 0, 255); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	return errors
+}
+
+// fhrpVirtualIPRole returns the IP address role of the virtual IPs of an FHRP
+// group using the given protocol
+func fhrpVirtualIPRole(protocol string) string {
+	switch protocol {
+	case FHRPProtocolVRRP2, FHRPProtocolVRRP3:
+		return IPAddressRoleVRRP
+	case FHRPProtocolHSRP:
+		return IPAddressRoleHSRP
+	case FHRPProtocolGLBP:
+		return IPAddressRoleGLBP
+	case FHRPProtocolCARP:
+		return IPAddressRoleCARP
+	default:
+		return IPAddressRoleVIP
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// FHRPGroupResource is the typed resource for ipam/fhrp-groups
+type FHRPGroupResource = Resource[FHRPGroup, CreateFHRPGroupInput, UpdateFHRPGroupInput, PatchFHRPGroupInput, ListFHRPGroupsInput]
+
+// FHRPGroups returns the typed resource for ipam/fhrp-groups
+func (c *Client) FHRPGroups() *FHRPGroupResource {
+	return NewResource[FHRPGroup, CreateFHRPGroupInput, UpdateFHRPGroupInput, PatchFHRPGroupInput, ListFHRPGroupsInput](c, "ipam", "fhrp-groups")
+}
+
+// FHRPGroupAssignmentResource is the typed resource for ipam/fhrp-group-assignments
+type FHRPGroupAssignmentResource = Resource[FHRPGroupAssignment, CreateFHRPGroupAssignmentInput, UpdateFHRPGroupAssignmentInput, PatchFHRPGroupAssignmentInput, ListFHRPGroupAssignmentsInput]
+
+// FHRPGroupAssignments returns the typed resource for ipam/fhrp-group-assignments
+func (c *Client) FHRPGroupAssignments() *FHRPGroupAssignmentResource {
+	return NewResource[FHRPGroupAssignment, CreateFHRPGroupAssignmentInput, UpdateFHRPGroupAssignmentInput, PatchFHRPGroupAssignmentInput, ListFHRPGroupAssignmentsInput](c, "ipam", "fhrp-group-assignments")
+}
+
+// CreateFHRPGroupWithAssignments creates an FHRP group, assigns its virtual
+// IPs and attaches it to the given interfaces. When a virtual IP or interface
+// assignment fails, the group is returned with everything attached to it so
+// far, leaving the caller to finish or delete it.
+func (c *Client) CreateFHRPGroupWithAssignments(input *CreateFHRPGroupWithAssignmentsInput) (*FHRPGroupWithAssignments, error) {
+	return c.CreateFHRPGroupWithAssignmentsWithContext(context.Background(), input)
+}
+
+// CreateFHRPGroupWithAssignmentsWithContext creates an FHRP group with its virtual IPs and interface assignments using the provided context
+func (c *Client) CreateFHRPGroupWithAssignmentsWithContext(ctx context.Context, input *CreateFHRPGroupWithAssignmentsInput) (*FHRPGroupWithAssignments, error) {
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	group, err := c.FHRPGroups().Create(ctx, &input.Group)
+	if err != nil {
+		return nil, fmt.Errorf("error creating FHRP group %s %d: %w", input.Group.Protocol, input.Group.GroupID, err)
+	}
+
+	result := &FHRPGroupWithAssignments{Group: group}
+	for _, ipInput := range input.VirtualIPs {
+		ipInput.AssignedObjectType = AssignedObjectTypeFHRPGroup
+		ipInput.AssignedObjectID = group.ID
+		if ipInput.Role == "" {
+			ipInput.Role = fhrpVirtualIPRole(input.Group.Protocol)
+		}
+
+		address, err := c.IPAddresses().Create(ctx, &ipInput)
+		if err != nil {
+			return result, fmt.Errorf("error creating virtual IP %s: %w", ipInput.Address, err)
+		}
+		result.VirtualIPs = append(result.VirtualIPs, *address)
+	}
+
+	for _, assignmentInput := range input.Assignments {
+		assignmentInput.Group = group.ID
+
+		assignment, err := c.FHRPGroupAssignments().Create(ctx, &assignmentInput)
+		if err != nil {
+			return result, fmt.Errorf("error assigning FHRP group to interface %d: %w", assignmentInput.InterfaceID, err)
+		}
+		result.Assignments = append(result.Assignments, *assignment)
+	}
+
+	return result, nil
+}
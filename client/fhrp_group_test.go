@@ -0,0 +1,86 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestCreateFHRPGroupWithAssignments(t *testing.T) {
+	var assigned []float64
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		switch r.URL.Path {
+		case "/api/ipam/fhrp-groups/":
+			assert.Equal(t, "vrrp3", body["protocol"])
+			assert.Equal(t, float64(10), body["group_id"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 5, "protocol": {"value": "vrrp3", "label": "VRRPv3"}, "group_id": 10}`))
+		case "/api/ipam/ip-addresses/":
+			assert.Equal(t, "ipam.fhrpgroup", body["assigned_object_type"])
+			assert.Equal(t, float64(5), body["assigned_object_id"])
+			assert.Equal(t, "vrrp", body["role"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 30, "address": "192.0.2.1/24", "role": {"value": "vrrp", "label": "VRRP"}, "assigned_object_type": "ipam.fhrpgroup", "assigned_object_id": 5}`))
+		case "/api/ipam/fhrp-group-assignments/":
+			assert.Equal(t, float64(5), body["group"])
+			assert.Equal(t, "dcim.interface", body["interface_type"])
+			assigned = append(assigned, body["interface_id"].(float64))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `{"id": %d, "group": {"id": 5}, "interface_type": "dcim.interface", "interface_id": %v, "priority": %v,
+				"interface": {"id": %v, "name": "Vlan100", "device": {"id": 1, "name": "sw1"}}}`, len(assigned), body["interface_id"], body["priority"], body["interface_id"])
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	result, err := client.CreateFHRPGroupWithAssignments(&CreateFHRPGroupWithAssignmentsInput{
+		Group:      CreateFHRPGroupInput{Protocol: FHRPProtocolVRRP3, GroupID: 10},
+		VirtualIPs: []CreateIPAddressInput{{Address: "192.0.2.1/24"}},
+		Assignments: []CreateFHRPGroupAssignmentInput{
+			{InterfaceType: AssignedObjectTypeInterface, InterfaceID: 101, Priority: 200},
+			{InterfaceType: AssignedObjectTypeInterface, InterfaceID: 102, Priority: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.Group.ID)
+	require.Len(t, result.VirtualIPs, 1)
+	assert.Equal(t, "vrrp", result.VirtualIPs[0].Role.Value)
+	require.Len(t, result.Assignments, 2)
+	assert.Equal(t, 200, result.Assignments[0].Priority)
+	assert.Equal(t, "sw1", result.Assignments[0].Interface.Device.Name)
+	assert.Equal(t, []float64{101, 102}, assigned)
+}
+
+func TestCreateFHRPGroupWithAssignmentsValidation(t *testing.T) {
+	client := NewClientForTesting(t)
+
+	_, err := client.CreateFHRPGroupWithAssignments(&CreateFHRPGroupWithAssignmentsInput{
+		Group:       CreateFHRPGroupInput{Protocol: "vrrp", GroupID: 10},
+		VirtualIPs:  []CreateIPAddressInput{{Address: "192.0.2.1/24"}},
+		Assignments: []CreateFHRPGroupAssignmentInput{{InterfaceType: AssignedObjectTypeInterface, InterfaceID: 101, Priority: 300}},
+	})
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+
+	var fields []string
+	for _, e := range validationErrors {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"protocol", "assignments[0].priority"}, fields)
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid 802.1Q modes for interfaces
+const (
+	InterfaceModeAccess    = "access"
+	InterfaceModeTagged    = "tagged"
+	InterfaceModeTaggedAll = "tagged-all"
+)
+
+// Common interface types. Netbox supports many more; any valid type slug may be used.
+const (
+	InterfaceTypeVirtual     = "virtual"
+	InterfaceTypeBridge      = "bridge"
+	InterfaceTypeLAG         = "lag"
+	InterfaceType100BaseTX   = "100base-tx"
+	InterfaceType1000BaseT   = "1000base-t"
+	InterfaceType10GBaseT    = "10gbase-t"
+	InterfaceType1GESFP      = "1000base-x-sfp"
+	InterfaceType10GESFPP    = "10gbase-x-sfpp"
+	InterfaceType25GESFP28   = "25gbase-x-sfp28"
+	InterfaceType40GEQSFPP   = "40gbase-x-qsfpp"
+	InterfaceType100GEQSFP28 = "100gbase-x-qsfp28"
+	InterfaceType400GEQSFPDD = "400gbase-x-qsfpdd"
+	InterfaceTypeOther       = "other"
+)
+
+// Interface represents a Netbox device interface
+type Interface struct {
+	ID                          int                    `json:"id"`
+	URL                         string                 `json:"url"`
+	Display                     string                 `json:"display"`
+	Device                      *Device                `json:"device"`
+	Module                      *NestedObject          `json:"module,omitempty"`
+	Name                        string                 `json:"name"`
+	Label                       string                 `json:"label,omitempty"`
+	Type                        *Choice                `json:"type"`
+	Enabled                     bool                   `json:"enabled"`
+	Parent                      *NestedObject          `json:"parent,omitempty"`
+	Bridge                      *NestedObject          `json:"bridge,omitempty"`
+	LAG                         *NestedObject          `json:"lag,omitempty"`
+	MTU                         *int                   `json:"mtu,omitempty"`
+	MACAddress                  *string                `json:"mac_address,omitempty"`
+	Speed                       *int                   `json:"speed,omitempty"`
+	Duplex                      *Choice                `json:"duplex,omitempty"`
+	WWN                         *string                `json:"wwn,omitempty"`
+	MgmtOnly                    bool                   `json:"mgmt_only"`
+	Description                 string                 `json:"description,omitempty"`
+	Mode                        *Choice                `json:"mode,omitempty"`
+	PoEMode                     *Choice                `json:"poe_mode,omitempty"`
+	PoEType                     *Choice                `json:"poe_type,omitempty"`
+	UntaggedVLAN                *NestedObject          `json:"untagged_vlan,omitempty"`
+	TaggedVLANs                 []NestedObject         `json:"tagged_vlans,omitempty"`
+	MarkConnected               bool                   `json:"mark_connected"`
+	Cable                       *NestedObject          `json:"cable,omitempty"`
+	CableEnd                    string                 `json:"cable_end,omitempty"`
+	LinkPeers                   []CableEndpoint        `json:"link_peers,omitempty"`
+	LinkPeersType               string                 `json:"link_peers_type,omitempty"`
+	ConnectedEndpoints          []CableEndpoint        `json:"connected_endpoints,omitempty"`
+	ConnectedEndpointsType      string                 `json:"connected_endpoints_type,omitempty"`
+	ConnectedEndpointsReachable bool                   `json:"connected_endpoints_reachable"`
+	VRF                         *NestedObject          `json:"vrf,omitempty"`
+	VDCs                        []VirtualDeviceContext `json:"vdcs,omitempty"`
+	Tags                        []models.Tag           `json:"tags,omitempty"`
+	CustomFields                map[string]any         `json:"custom_fields,omitempty"`
+	Created                     string                 `json:"created"`
+	LastUpdated                 string                 `json:"last_updated"`
+	CountIPAddresses            int                    `json:"count_ipaddresses"`
+	CountFHRPGroups             int                    `json:"count_fhrp_groups"`
+	Occupied                    bool                   `json:"_occupied"`
+}
+
+// CreateInterfaceInput represents the input for creating an interface
+type CreateInterfaceInput struct {
+	Device        int                `json:"device"`
+	Module        int                `json:"module,omitempty"`
+	Name          string             `json:"name"`
+	Label         string             `json:"label,omitempty"`
+	Type          string             `json:"type"`
+	Enabled       *bool              `json:"enabled,omitempty"`
+	Parent        int                `json:"parent,omitempty"`
+	Bridge        int                `json:"bridge,omitempty"`
+	LAG           int                `json:"lag,omitempty"`
+	MTU           *int               `json:"mtu,omitempty"`
+	MACAddress    string             `json:"mac_address,omitempty"`
+	Speed         *int               `json:"speed,omitempty"`
+	Duplex        string             `json:"duplex,omitempty"`
+	WWN           string             `json:"wwn,omitempty"`
+	MgmtOnly      bool               `json:"mgmt_only,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Mode          string             `json:"mode,omitempty"`
+	PoEMode       string             `json:"poe_mode,omitempty"`
+	PoEType       string             `json:"poe_type,omitempty"`
+	UntaggedVLAN  int                `json:"untagged_vlan,omitempty"`
+	TaggedVLANs   []int              `json:"tagged_vlans,omitempty"`
+	MarkConnected bool               `json:"mark_connected,omitempty"`
+	VRF           int                `json:"vrf,omitempty"`
+	VDCs          []int              `json:"vdcs,omitempty"`
+	Tags          []models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateInterfaceInput
+func (input *CreateInterfaceInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Device == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device",
+			Message: "Device is required",
+		})
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validateInterfaceVLANs(input.Mode, input.UntaggedVLAN != 0, len(input.TaggedVLANs) > 0)...)
+
+	if input.MTU != nil {
+		if err := models.ValidateRange("mtu", float64(*input.MTU), 1, 65536); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateInterfaceInput represents the input for updating an interface
+type UpdateInterfaceInput CreateInterfaceInput
+
+// Validate validates the UpdateInterfaceInput
+func (input *UpdateInterfaceInput) Validate() error {
+	return (*CreateInterfaceInput)(input).Validate()
+}
+
+// PatchInterfaceInput represents the input for patching an interface
+type PatchInterfaceInput struct {
+	Device        *int                `json:"device,omitempty"`
+	Module        *int                `json:"module,omitempty"`
+	Name          *string             `json:"name,omitempty"`
+	Label         *string             `json:"label,omitempty"`
+	Type          *string             `json:"type,omitempty"`
+	Enabled       *bool               `json:"enabled,omitempty"`
+	Parent        *int                `json:"parent,omitempty"`
+	Bridge        *int                `json:"bridge,omitempty"`
+	LAG           *int                `json:"lag,omitempty"`
+	MTU           *int                `json:"mtu,omitempty"`
+	MACAddress    *string             `json:"mac_address,omitempty"`
+	Speed         *int                `json:"speed,omitempty"`
+	Duplex        *string             `json:"duplex,omitempty"`
+	WWN           *string             `json:"wwn,omitempty"`
+	MgmtOnly      *bool               `json:"mgmt_only,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Mode          *string             `json:"mode,omitempty"`
+	PoEMode       *string             `json:"poe_mode,omitempty"`
+	PoEType       *string             `json:"poe_type,omitempty"`
+	UntaggedVLAN  *int                `json:"untagged_vlan,omitempty"`
+	TaggedVLANs   *[]int              `json:"tagged_vlans,omitempty"`
+	MarkConnected *bool               `json:"mark_connected,omitempty"`
+	VRF           *int                `json:"vrf,omitempty"`
+	VDCs          *[]int              `json:"vdcs,omitempty"`
+	Tags          *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchInterfaceInput
+func (input *PatchInterfaceInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Mode != nil {
+		errors = append(errors, validateInterfaceVLANs(*input.Mode, input.UntaggedVLAN != nil, input.TaggedVLANs != nil && len(*input.TaggedVLANs) > 0)...)
+	}
+
+	if input.MTU != nil {
+		if err := models.ValidateRange("mtu", float64(*input.MTU), 1, 65536); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateInterfaceVLANs checks that VLAN assignments are consistent with the 802.1Q mode
+func validateInterfaceVLANs(mode string, hasUntagged, hasTagged bool) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if mode != "" {
+		if err := models.ValidateOneOf("mode", mode, InterfaceModeAccess, InterfaceModeTagged, InterfaceModeTaggedAll); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if hasUntagged && mode == "" {
+		errors = append(errors, models.ValidationError{
+			Field:   "untagged_vlan",
+			Message: "requires an 802.1Q mode",
+		})
+	}
+
+	if hasTagged && mode != InterfaceModeTagged {
+		errors = append(errors, models.ValidationError{
+			Field:   "tagged_vlans",
+			Message: "requires mode to be tagged",
+		})
+	}
+
+	return errors
+}
+
+// ListInterfacesInput represents the input for listing interfaces. Slice
+// fields match any of the given values, so interfaces of many devices can be
+// fetched in a single request.
+type ListInterfacesInput struct {
+	Query      string   `query:"q"`           // General search
+	DeviceID   []int    `query:"device_id"`   // Filter by device ID
+	Device     []string `query:"device"`      // Filter by device name
+	SiteID     []int    `query:"site_id"`     // Filter by site ID
+	Name       []string `query:"name"`        // Filter by name (exact match)
+	Type       []string `query:"type"`        // Filter by interface type
+	Kind       string   `query:"kind"`        // Filter by kind: physical, virtual or wireless
+	Mode       []string `query:"mode"`        // Filter by 802.1Q mode
+	LAGID      []int    `query:"lag_id"`      // Filter by parent LAG ID
+	VLANID     []int    `query:"vlan_id"`     // Filter by assigned VLAN ID
+	VDCID      []int    `query:"vdc_id"`      // Filter by assigned virtual device context ID
+	VDC        []string `query:"vdc"`         // Filter by assigned virtual device context name
+	MACAddress []string `query:"mac_address"` // Filter by MAC address
+	Enabled    *bool    `query:"enabled"`     // Filter by enabled state
+	MgmtOnly   *bool    `query:"mgmt_only"`   // Filter by management-only flag
+	Cabled     *bool    `query:"cabled"`      // Filter by whether a cable is attached
+	Connected  *bool    `query:"connected"`   // Filter by whether the interface is connected
+	Tag        []string `query:"tag"`         // Filter by tag slug
+	Limit      int      `query:"limit"`       // Number of results to return per page
+	Offset     int      `query:"offset"`      // The initial index from which to return the results
+}
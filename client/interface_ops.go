@@ -0,0 +1,9 @@
+package client
+
+// InterfaceResource is the typed resource for dcim/interfaces
+type InterfaceResource = Resource[Interface, CreateInterfaceInput, UpdateInterfaceInput, PatchInterfaceInput, ListInterfacesInput]
+
+// Interfaces returns the typed resource for dcim/interfaces
+func (c *Client) Interfaces() *InterfaceResource {
+	return NewResource[Interface, CreateInterfaceInput, UpdateInterfaceInput, PatchInterfaceInput, ListInterfacesInput](c, "dcim", "interfaces")
+}
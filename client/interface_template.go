@@ -0,0 +1,81 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// InterfaceTemplate represents a Netbox interface template
+type InterfaceTemplate struct {
+	ComponentTemplate
+	Type     *Choice       `json:"type"`
+	Enabled  bool          `json:"enabled"`
+	MgmtOnly bool          `json:"mgmt_only"`
+	Bridge   *NestedObject `json:"bridge,omitempty"`
+	PoEMode  *Choice       `json:"poe_mode,omitempty"`
+	PoEType  *Choice       `json:"poe_type,omitempty"`
+	RFRole   *Choice       `json:"rf_role,omitempty"`
+}
+
+// CreateInterfaceTemplateInput represents the input for creating an interface template
+type CreateInterfaceTemplateInput struct {
+	ComponentTemplateInput
+	Type     string `json:"type"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+	MgmtOnly bool   `json:"mgmt_only,omitempty"`
+	Bridge   int    `json:"bridge,omitempty"`
+	PoEMode  string `json:"poe_mode,omitempty"`
+	PoEType  string `json:"poe_type,omitempty"`
+	RFRole   string `json:"rf_role,omitempty"`
+}
+
+// Validate validates the CreateInterfaceTemplateInput
+func (input *CreateInterfaceTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateInterfaceTemplateInput represents the input for updating an interface template
+type UpdateInterfaceTemplateInput CreateInterfaceTemplateInput
+
+// Validate validates the UpdateInterfaceTemplateInput
+func (input *UpdateInterfaceTemplateInput) Validate() error {
+	return (*CreateInterfaceTemplateInput)(input).Validate()
+}
+
+// PatchInterfaceTemplateInput represents the input for patching an interface template
+type PatchInterfaceTemplateInput struct {
+	PatchComponentTemplateInput
+	Type     *string `json:"type,omitempty"`
+	Enabled  *bool   `json:"enabled,omitempty"`
+	MgmtOnly *bool   `json:"mgmt_only,omitempty"`
+	Bridge   *int    `json:"bridge,omitempty"`
+	PoEMode  *string `json:"poe_mode,omitempty"`
+	PoEType  *string `json:"poe_type,omitempty"`
+	RFRole   *string `json:"rf_role,omitempty"`
+}
+
+// Validate validates the PatchInterfaceTemplateInput
+func (input *PatchInterfaceTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.Type != nil {
+		if err := models.ValidateRequired("type", *input.Type); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
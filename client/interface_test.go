@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInterface(t *testing.T) {
+	client := newMockClient(t, "/api/dcim/interfaces/10/", `{
+		"id": 10,
+		"device": {"id": 1, "name": "leaf01"},
+		"name": "Ethernet1",
+		"type": {"value": "10gbase-x-sfpp", "label": "SFP+ (10GE)"},
+		"enabled": true,
+		"mtu": 9216,
+		"mode": {"value": "tagged", "label": "Tagged"},
+		"untagged_vlan": {"id": 100, "display": "VLAN 100"},
+		"tagged_vlans": [{"id": 200}, {"id": 201}],
+		"cable": {"id": 5},
+		"connected_endpoints": [{"id": 20, "url": "http://netbox/api/dcim/interfaces/20/", "display": "Ethernet49",
+			"device": {"id": 2, "name": "spine01"}, "name": "Ethernet49",
+			"cable": {"id": 5, "url": "http://netbox/api/dcim/cables/5/", "display": "#5"}, "_occupied": true}],
+		"connected_endpoints_type": "dcim.interface",
+		"connected_endpoints_reachable": true
+	}`, http.StatusOK)
+
+	iface, err := client.Interfaces().Get(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, "leaf01", iface.Device.Name)
+	assert.Equal(t, InterfaceType10GESFPP, iface.Type.Value)
+	assert.Equal(t, InterfaceModeTagged, iface.Mode.Value)
+	assert.Equal(t, 9216, *iface.MTU)
+	assert.Len(t, iface.TaggedVLANs, 2)
+	require.Len(t, iface.ConnectedEndpoints, 1)
+	assert.Equal(t, "spine01", iface.ConnectedEndpoints[0].Device.Name)
+	require.NotNil(t, iface.ConnectedEndpoints[0].Cable)
+	assert.Equal(t, 5, iface.ConnectedEndpoints[0].Cable.ID)
+	assert.Equal(t, "dcim.interface", iface.ConnectedEndpointsType)
+}
+
+func TestCreateInterfaceInputValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CreateInterfaceInput
+		wantErr bool
+	}{
+		{
+			name:  "access port",
+			input: CreateInterfaceInput{Device: 1, Name: "eth0", Type: InterfaceType1000BaseT, Mode: InterfaceModeAccess, UntaggedVLAN: 10},
+		},
+		{
+			name:  "trunk port",
+			input: CreateInterfaceInput{Device: 1, Name: "eth1", Type: InterfaceType1000BaseT, Mode: InterfaceModeTagged, TaggedVLANs: []int{10, 20}},
+		},
+		{
+			name:    "tagged VLANs on access port",
+			input:   CreateInterfaceInput{Device: 1, Name: "eth2", Type: InterfaceType1000BaseT, Mode: InterfaceModeAccess, TaggedVLANs: []int{10}},
+			wantErr: true,
+		},
+		{
+			name:    "untagged VLAN without mode",
+			input:   CreateInterfaceInput{Device: 1, Name: "eth3", Type: InterfaceType1000BaseT, UntaggedVLAN: 10},
+			wantErr: true,
+		},
+		{
+			name:    "missing device and type",
+			input:   CreateInterfaceInput{Name: "eth4"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
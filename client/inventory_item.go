@@ -0,0 +1,86 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// InventoryItem represents a Netbox inventory item, a piece of hardware
+// within a device which is not modeled as a component of its own. Items may
+// be nested under a parent item or tied to another component of the device.
+type InventoryItem struct {
+	Component
+	Parent            *NestedObject `json:"parent,omitempty"`
+	Role              *NestedObject `json:"role,omitempty"`
+	Manufacturer      *Manufacturer `json:"manufacturer,omitempty"`
+	PartID            string        `json:"part_id,omitempty"`
+	Serial            string        `json:"serial,omitempty"`
+	AssetTag          *string       `json:"asset_tag,omitempty"`
+	Discovered        bool          `json:"discovered"`
+	ComponentType     *string       `json:"component_type,omitempty"`
+	ComponentID       *int          `json:"component_id,omitempty"`
+	AssignedComponent *NestedObject `json:"component,omitempty"`
+	Depth             int           `json:"_depth"`
+}
+
+// CreateInventoryItemInput represents the input for creating an inventory item
+type CreateInventoryItemInput struct {
+	ComponentInput
+	Parent        int    `json:"parent,omitempty"`
+	Role          int    `json:"role,omitempty"`
+	Manufacturer  int    `json:"manufacturer,omitempty"`
+	PartID        string `json:"part_id,omitempty"`
+	Serial        string `json:"serial,omitempty"`
+	AssetTag      string `json:"asset_tag,omitempty"`
+	Discovered    bool   `json:"discovered,omitempty"`
+	ComponentType string `json:"component_type,omitempty"`
+	ComponentID   int    `json:"component_id,omitempty"`
+}
+
+// Validate validates the CreateInventoryItemInput
+func (input *CreateInventoryItemInput) Validate() error {
+	errors := input.ComponentInput.validate()
+
+	if (input.ComponentType == "") != (input.ComponentID == 0) {
+		errors = append(errors, models.ValidationError{
+			Field:   "component_id",
+			Message: "component type and component ID must be set together",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateInventoryItemInput represents the input for updating an inventory item
+type UpdateInventoryItemInput CreateInventoryItemInput
+
+// Validate validates the UpdateInventoryItemInput
+func (input *UpdateInventoryItemInput) Validate() error {
+	return (*CreateInventoryItemInput)(input).Validate()
+}
+
+// PatchInventoryItemInput represents the input for patching an inventory item
+type PatchInventoryItemInput struct {
+	PatchComponentInput
+	Parent        *int    `json:"parent,omitempty"`
+	Role          *int    `json:"role,omitempty"`
+	Manufacturer  *int    `json:"manufacturer,omitempty"`
+	PartID        *string `json:"part_id,omitempty"`
+	Serial        *string `json:"serial,omitempty"`
+	AssetTag      *string `json:"asset_tag,omitempty"`
+	Discovered    *bool   `json:"discovered,omitempty"`
+	ComponentType *string `json:"component_type,omitempty"`
+	ComponentID   *int    `json:"component_id,omitempty"`
+}
+
+// Validate validates the PatchInventoryItemInput
+func (input *PatchInventoryItemInput) Validate() error {
+	if errors := input.PatchComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// InventoryItemTemplate represents a Netbox inventory item template.
+// Inventory item templates can only belong to a device type, and may be
+// nested under a parent template or tied to another component template.
+type InventoryItemTemplate struct {
+	ComponentTemplate
+	Parent        *NestedObject `json:"parent,omitempty"`
+	Role          *NestedObject `json:"role,omitempty"`
+	Manufacturer  *Manufacturer `json:"manufacturer,omitempty"`
+	PartID        string        `json:"part_id,omitempty"`
+	ComponentType *string       `json:"component_type,omitempty"`
+	ComponentID   *int          `json:"component_id,omitempty"`
+	Component     *NestedObject `json:"component,omitempty"`
+	Depth         int           `json:"_depth"`
+}
+
+// CreateInventoryItemTemplateInput represents the input for creating an inventory item template
+type CreateInventoryItemTemplateInput struct {
+	ComponentTemplateInput
+	Parent        int    `json:"parent,omitempty"`
+	Role          int    `json:"role,omitempty"`
+	Manufacturer  int    `json:"manufacturer,omitempty"`
+	PartID        string `json:"part_id,omitempty"`
+	ComponentType string `json:"component_type,omitempty"`
+	ComponentID   int    `json:"component_id,omitempty"`
+}
+
+// Validate validates the CreateInventoryItemTemplateInput
+func (input *CreateInventoryItemTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+	errors = append(errors, validateDeviceTypeOnly(input.ModuleType)...)
+
+	if (input.ComponentType == "") != (input.ComponentID == 0) {
+		errors = append(errors, models.ValidationError{
+			Field:   "component_id",
+			Message: "component type and component ID must be set together",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateInventoryItemTemplateInput represents the input for updating an inventory item template
+type UpdateInventoryItemTemplateInput CreateInventoryItemTemplateInput
+
+// Validate validates the UpdateInventoryItemTemplateInput
+func (input *UpdateInventoryItemTemplateInput) Validate() error {
+	return (*CreateInventoryItemTemplateInput)(input).Validate()
+}
+
+// PatchInventoryItemTemplateInput represents the input for patching an inventory item template
+type PatchInventoryItemTemplateInput struct {
+	PatchComponentTemplateInput
+	Parent        *int    `json:"parent,omitempty"`
+	Role          *int    `json:"role,omitempty"`
+	Manufacturer  *int    `json:"manufacturer,omitempty"`
+	PartID        *string `json:"part_id,omitempty"`
+	ComponentType *string `json:"component_type,omitempty"`
+	ComponentID   *int    `json:"component_id,omitempty"`
+}
+
+// Validate validates the PatchInventoryItemTemplateInput
+func (input *PatchInventoryItemTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.ModuleType != nil {
+		errors = append(errors, validateDeviceTypeOnly(*input.ModuleType)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
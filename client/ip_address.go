@@ -0,0 +1,244 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for IP addresses
+const (
+	IPAddressStatusActive     = "active"
+	IPAddressStatusReserved   = "reserved"
+	IPAddressStatusDeprecated = "deprecated"
+	IPAddressStatusDHCP       = "dhcp"
+	IPAddressStatusSLAAC      = "slaac"
+)
+
+// ipAddressStatuses lists all valid IP address status values
+var ipAddressStatuses = []string{
+	IPAddressStatusActive,
+	IPAddressStatusReserved,
+	IPAddressStatusDeprecated,
+	IPAddressStatusDHCP,
+	IPAddressStatusSLAAC,
+}
+
+// Valid role values for IP addresses
+const (
+	IPAddressRoleLoopback  = "loopback"
+	IPAddressRoleSecondary = "secondary"
+	IPAddressRoleAnycast   = "anycast"
+	IPAddressRoleVIP       = "vip"
+	IPAddressRoleVRRP      = "vrrp"
+	IPAddressRoleHSRP      = "hsrp"
+	IPAddressRoleGLBP      = "glbp"
+	IPAddressRoleCARP      = "carp"
+)
+
+// ipAddressRoles lists all valid IP address role values
+var ipAddressRoles = []string{
+	IPAddressRoleLoopback,
+	IPAddressRoleSecondary,
+	IPAddressRoleAnycast,
+	IPAddressRoleVIP,
+	IPAddressRoleVRRP,
+	IPAddressRoleHSRP,
+	IPAddressRoleGLBP,
+	IPAddressRoleCARP,
+}
+
+// Object types an IP address can be assigned to
+const (
+	AssignedObjectTypeInterface   = "dcim.interface"
+	AssignedObjectTypeVMInterface = "virtualization.vminterface"
+	AssignedObjectTypeFHRPGroup   = "ipam.fhrpgroup"
+)
+
+// AssignedObject represents the object an IP address is assigned to. Device is
+// set for dcim.interface assignments and VirtualMachine for
+// virtualization.vminterface assignments.
+type AssignedObject struct {
+	ID             int           `json:"id"`
+	URL            string        `json:"url"`
+	Display        string        `json:"display"`
+	Name           string        `json:"name,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	Device         *Device       `json:"device,omitempty"`
+	VirtualMachine *NestedObject `json:"virtual_machine,omitempty"`
+}
+
+// IPAddress represents a Netbox IP address
+type IPAddress struct {
+	ID                 int             `json:"id"`
+	URL                string          `json:"url"`
+	Display            string          `json:"display"`
+	Family             *AddressFamily  `json:"family"`
+	Address            string          `json:"address"`
+	VRF                *NestedObject   `json:"vrf,omitempty"`
+	Tenant             *NestedObject   `json:"tenant,omitempty"`
+	Status             *Status         `json:"status"`
+	Role               *Choice         `json:"role,omitempty"`
+	AssignedObjectType string          `json:"assigned_object_type,omitempty"`
+	AssignedObjectID   *int            `json:"assigned_object_id,omitempty"`
+	AssignedObject     *AssignedObject `json:"assigned_object,omitempty"`
+	NATInside          *IPAddress      `json:"nat_inside,omitempty"`
+	NATOutside         []IPAddress     `json:"nat_outside,omitempty"`
+	DNSName            string          `json:"dns_name,omitempty"`
+	Description        string          `json:"description,omitempty"`
+	Comments           string          `json:"comments,omitempty"`
+	Tags               []models.Tag    `json:"tags,omitempty"`
+	CustomFields       map[string]any  `json:"custom_fields,omitempty"`
+	Created            string          `json:"created"`
+	LastUpdated        string          `json:"last_updated"`
+}
+
+// CreateIPAddressInput represents the input for creating an IP address
+type CreateIPAddressInput struct {
+	Address            string             `json:"address"`
+	VRF                int                `json:"vrf,omitempty"`
+	Tenant             int                `json:"tenant,omitempty"`
+	Status             string             `json:"status,omitempty"`
+	Role               string             `json:"role,omitempty"`
+	AssignedObjectType string             `json:"assigned_object_type,omitempty"`
+	AssignedObjectID   int                `json:"assigned_object_id,omitempty"`
+	NATInside          int                `json:"nat_inside,omitempty"`
+	DNSName            string             `json:"dns_name,omitempty"`
+	Description        string             `json:"description,omitempty"`
+	Comments           string             `json:"comments,omitempty"`
+	Tags               []models.TagCreate `json:"tags,omitempty"`
+	CustomFields       map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateIPAddressInput
+func (input *CreateIPAddressInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidatePrefix("address", input.Address); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validateIPAddressFields(input.Status, input.Role, input.AssignedObjectType, input.AssignedObjectID != 0)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateIPAddressInput represents the input for updating an IP address
+type UpdateIPAddressInput CreateIPAddressInput
+
+// Validate validates the UpdateIPAddressInput
+func (input *UpdateIPAddressInput) Validate() error {
+	return (*CreateIPAddressInput)(input).Validate()
+}
+
+// PatchIPAddressInput represents the input for patching an IP address
+type PatchIPAddressInput struct {
+	Address            *string             `json:"address,omitempty"`
+	VRF                *int                `json:"vrf,omitempty"`
+	Tenant             *int                `json:"tenant,omitempty"`
+	Status             *string             `json:"status,omitempty"`
+	Role               *string             `json:"role,omitempty"`
+	AssignedObjectType *string             `json:"assigned_object_type,omitempty"`
+	AssignedObjectID   *int                `json:"assigned_object_id,omitempty"`
+	NATInside          *int                `json:"nat_inside,omitempty"`
+	DNSName            *string             `json:"dns_name,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	Comments           *string             `json:"comments,omitempty"`
+	Tags               *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields       map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchIPAddressInput
+func (input *PatchIPAddressInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Address != nil {
+		if err := models.ValidatePrefix("address", *input.Address); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	var status, role, assignedObjectType string
+	if input.Status != nil {
+		status = *input.Status
+	}
+	if input.Role != nil {
+		role = *input.Role
+	}
+	if input.AssignedObjectType != nil {
+		assignedObjectType = *input.AssignedObjectType
+	}
+	if (input.AssignedObjectType == nil) != (input.AssignedObjectID == nil) {
+		errors = append(errors, models.ValidationError{
+			Field:   "assigned_object_id",
+			Message: "assigned_object_type and assigned_object_id must be set together",
+		})
+	} else {
+		errors = append(errors, validateIPAddressFields(status, role, assignedObjectType, input.AssignedObjectID != nil)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateIPAddressFields checks the status, role and assignment fields shared by IP address inputs
+func validateIPAddressFields(status, role, assignedObjectType string, hasAssignedObjectID bool) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if status != "" {
+		if err := models.ValidateOneOf("status", status, ipAddressStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if role != "" {
+		if err := models.ValidateOneOf("role", role, ipAddressRoles...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if assignedObjectType != "" {
+		if err := models.ValidateOneOf("assigned_object_type", assignedObjectType, AssignedObjectTypeInterface, AssignedObjectTypeVMInterface, AssignedObjectTypeFHRPGroup); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if (assignedObjectType != "") != hasAssignedObjectID {
+		errors = append(errors, models.ValidationError{
+			Field:   "assigned_object_id",
+			Message: "assigned_object_type and assigned_object_id must be set together",
+		})
+	}
+
+	return errors
+}
+
+// ListIPAddressesInput represents the input for listing IP addresses. Slice
+// fields match any of the given values.
+type ListIPAddressesInput struct {
+	Query               string   `query:"q"`                     // General search
+	Address             []string `query:"address"`               // Filter by address
+	Parent              []string `query:"parent"`                // Filter by parent prefix
+	Family              int      `query:"family"`                // Filter by address family (4 or 6)
+	MaskLength          []int    `query:"mask_length"`           // Filter by mask length
+	VRFID               []int    `query:"vrf_id"`                // Filter by VRF ID
+	GlobalVRF           bool     `query:"vrf_id,null"`           // Only return addresses in the global table, outside of any VRF
+	TenantID            []int    `query:"tenant_id"`             // Filter by tenant ID
+	Status              []string `query:"status"`                // Filter by status
+	Role                []string `query:"role"`                  // Filter by role
+	DeviceID            []int    `query:"device_id"`             // Filter by assigned device ID
+	Device              []string `query:"device"`                // Filter by assigned device name
+	InterfaceID         []int    `query:"interface_id"`          // Filter by assigned interface ID
+	VirtualMachineID    []int    `query:"virtual_machine_id"`    // Filter by assigned virtual machine ID
+	VMInterfaceID       []int    `query:"vminterface_id"`        // Filter by assigned VM interface ID
+	AssignedToInterface *bool    `query:"assigned_to_interface"` // Filter by whether the address is assigned to an interface
+	DNSName             []string `query:"dns_name"`              // Filter by DNS name
+	Tag                 []string `query:"tag"`                   // Filter by tag slug
+	Limit               int      `query:"limit"`                 // Number of results to return per page
+	Offset              int      `query:"offset"`                // The initial index from which to return the results
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// IPAddressResource is the typed resource for ipam/ip-addresses
+type IPAddressResource = Resource[IPAddress, CreateIPAddressInput, UpdateIPAddressInput, PatchIPAddressInput, ListIPAddressesInput]
+
+// IPAddresses returns the typed resource for ipam/ip-addresses
+func (c *Client) IPAddresses() *IPAddressResource {
+	return NewResource[IPAddress, CreateIPAddressInput, UpdateIPAddressInput, PatchIPAddressInput, ListIPAddressesInput](c, "ipam", "ip-addresses")
+}
+
+// AssignPrimaryIP assigns an IP address to a device interface and sets it as
+// the device's primary_ip4 or primary_ip6, depending on the address family
+func (c *Client) AssignPrimaryIP(ipAddressID, interfaceID int) (*Device, error) {
+	return c.AssignPrimaryIPWithContext(context.Background(), ipAddressID, interfaceID)
+}
+
+// AssignPrimaryIPWithContext assigns an IP address to a device interface and sets it as the device's primary IP using the provided context
+func (c *Client) AssignPrimaryIPWithContext(ctx context.Context, ipAddressID, interfaceID int) (*Device, error) {
+	objectType := AssignedObjectTypeInterface
+	address, err := c.IPAddresses().Patch(ctx, ipAddressID, &PatchIPAddressInput{
+		AssignedObjectType: &objectType,
+		AssignedObjectID:   &interfaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deviceID int
+	if address.AssignedObject != nil && address.AssignedObject.Device != nil {
+		deviceID = address.AssignedObject.Device.ID
+	} else {
+		iface, err := c.Interfaces().Get(ctx, interfaceID)
+		if err != nil {
+			return nil, err
+		}
+		if iface.Device == nil {
+			return nil, fmt.Errorf("interface %d is not attached to a device", interfaceID)
+		}
+		deviceID = iface.Device.ID
+	}
+
+	patch := &PatchDeviceInput{}
+	switch {
+	case address.Family != nil && address.Family.Value == 6:
+		patch.PrimaryIP6 = &address.ID
+	case address.Family != nil && address.Family.Value == 4:
+		patch.PrimaryIP4 = &address.ID
+	default:
+		return nil, fmt.Errorf("unknown address family for IP address %d", address.ID)
+	}
+
+	return c.Devices().Patch(ctx, deviceID, patch)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignPrimaryIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		family    int
+		wantField string
+	}{
+		{
+			name:      "IPv4",
+			family:    4,
+			wantField: "primary_ip4",
+		},
+		{
+			name:      "IPv6",
+			family:    6,
+			wantField: "primary_ip6",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/api/ipam/ip-addresses/5/":
+					assert.Equal(t, AssignedObjectTypeInterface, body["assigned_object_type"])
+					assert.Equal(t, float64(10), body["assigned_object_id"])
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"id":                   5,
+						"family":               map[string]any{"value": tt.family},
+						"assigned_object_type": AssignedObjectTypeInterface,
+						"assigned_object_id":   10,
+						"assigned_object":      map[string]any{"id": 10, "name": "mgmt0", "device": map[string]any{"id": 3}},
+					})
+				case "/api/dcim/devices/3/":
+					assert.Equal(t, map[string]any{tt.wantField: float64(5)}, body)
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"id":         3,
+						tt.wantField: map[string]any{"id": 5},
+					})
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+			})
+
+			client := newTestClient(t, ts)
+
+			device, err := client.AssignPrimaryIP(5, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 3, device.ID)
+		})
+	}
+}
+
+func TestCreateIPAddressInputValidate(t *testing.T) {
+	assert.NoError(t, (&CreateIPAddressInput{
+		Address:            "192.0.2.1/24",
+		Status:             IPAddressStatusActive,
+		Role:               IPAddressRoleLoopback,
+		AssignedObjectType: AssignedObjectTypeVMInterface,
+		AssignedObjectID:   1,
+	}).Validate())
+
+	assert.Error(t, (&CreateIPAddressInput{Address: "192.0.2.1"}).Validate())
+	assert.Error(t, (&CreateIPAddressInput{Address: "192.0.2.1/24", AssignedObjectID: 1}).Validate())
+	assert.Error(t, (&CreateIPAddressInput{Address: "2001:db8::1/64", Role: "bogus"}).Validate())
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"net/netip"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for IP ranges
+const (
+	IPRangeStatusActive     = "active"
+	IPRangeStatusReserved   = "reserved"
+	IPRangeStatusDeprecated = "deprecated"
+)
+
+// ipRangeStatuses lists all valid IP range status values
+var ipRangeStatuses = []string{
+	IPRangeStatusActive,
+	IPRangeStatusReserved,
+	IPRangeStatusDeprecated,
+}
+
+// IPRange represents a Netbox IP range, an arbitrary span of addresses such
+// as a DHCP pool. Both bounds are inclusive and carry the mask of the
+// enclosing network, e.g. "192.0.2.100/24".
+type IPRange struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Family       *AddressFamily `json:"family"`
+	StartAddress string         `json:"start_address"`
+	EndAddress   string         `json:"end_address"`
+	Size         int            `json:"size"`
+	VRF          *NestedObject  `json:"vrf,omitempty"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	Status       *Status        `json:"status"`
+	Role         *NestedObject  `json:"role,omitempty"`
+	MarkUtilized bool           `json:"mark_utilized"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateIPRangeInput represents the input for creating an IP range
+type CreateIPRangeInput struct {
+	StartAddress string             `json:"start_address"`
+	EndAddress   string             `json:"end_address"`
+	VRF          int                `json:"vrf,omitempty"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Role         int                `json:"role,omitempty"`
+	MarkUtilized bool               `json:"mark_utilized,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateIPRangeInput
+func (input *CreateIPRangeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	errors = append(errors, validateIPRangeBounds(input.StartAddress, input.EndAddress)...)
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, ipRangeStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateIPRangeInput represents the input for updating an IP range
+type UpdateIPRangeInput CreateIPRangeInput
+
+// Validate validates the UpdateIPRangeInput
+func (input *UpdateIPRangeInput) Validate() error {
+	return (*CreateIPRangeInput)(input).Validate()
+}
+
+// PatchIPRangeInput represents the input for patching an IP range. When only
+// one bound is given it is checked on its own; Netbox checks it against the
+// stored bound.
+type PatchIPRangeInput struct {
+	StartAddress *string             `json:"start_address,omitempty"`
+	EndAddress   *string             `json:"end_address,omitempty"`
+	VRF          *int                `json:"vrf,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Status       *string             `json:"status,omitempty"`
+	Role         *int                `json:"role,omitempty"`
+	MarkUtilized *bool               `json:"mark_utilized,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchIPRangeInput
+func (input *PatchIPRangeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	switch {
+	case input.StartAddress != nil && input.EndAddress != nil:
+		errors = append(errors, validateIPRangeBounds(*input.StartAddress, *input.EndAddress)...)
+	case input.StartAddress != nil:
+		if err := models.ValidatePrefix("start_address", *input.StartAddress); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	case input.EndAddress != nil:
+		if err := models.ValidatePrefix("end_address", *input.EndAddress); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, ipRangeStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListIPRangesInput represents the input for listing IP ranges. Slice fields
+// match any of the given values.
+type ListIPRangesInput struct {
+	Query        string   `query:"q"`             // General search
+	StartAddress []string `query:"start_address"` // Filter by start address
+	EndAddress   []string `query:"end_address"`   // Filter by end address
+	Contains     string   `query:"contains"`      // Filter by ranges containing the given address
+	Family       int      `query:"family"`        // Filter by address family (4 or 6)
+	VRFID        []int    `query:"vrf_id"`        // Filter by VRF ID
+	TenantID     []int    `query:"tenant_id"`     // Filter by tenant ID
+	RoleID       []int    `query:"role_id"`       // Filter by role ID
+	Status       []string `query:"status"`        // Filter by status
+	MarkUtilized *bool    `query:"mark_utilized"` // Filter by whether the range is marked fully utilized
+	Tag          []string `query:"tag"`           // Filter by tag slug
+	Limit        int      `query:"limit"`         // Number of results to return per page
+	Offset       int      `query:"offset"`        // The initial index from which to return the results
+}
+
+// IPRangeUtilization summarizes the addresses of an IP range which are in use
+type IPRangeUtilization struct {
+	Range       *IPRange
+	Size        int         // Number of addresses in the range
+	Used        int         // Number of addresses in use; the full size if the range is marked utilized
+	IPAddresses []IPAddress // IP addresses within the range and its VRF
+}
+
+// Available returns the number of addresses in the range which are not in use
+func (u *IPRangeUtilization) Available() int {
+	return u.Size - u.Used
+}
+
+// Percent returns the addresses in use as a percentage of the range size
+func (u *IPRangeUtilization) Percent() float64 {
+	if u.Size == 0 {
+		return 0
+	}
+
+	return float64(u.Used) / float64(u.Size) * 100
+}
+
+// validateIPRangeBounds checks that both bounds are addresses with a mask, of
+// the same family and mask length, with the start not after the end
+func validateIPRangeBounds(start, end string) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if err := models.ValidatePrefix("start_address", start); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidatePrefix("end_address", end); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	startPrefix, endPrefix := netip.MustParsePrefix(start), netip.MustParsePrefix(end)
+	switch {
+	case startPrefix.Addr().Is4() != endPrefix.Addr().Is4():
+		errors = append(errors, models.ValidationError{
+			Field:   "end_address",
+			Message: "must be of the same address family as start_address",
+		})
+	case startPrefix.Bits() != endPrefix.Bits():
+		errors = append(errors, models.ValidationError{
+			Field:   "end_address",
+			Message: "must have the same mask length as start_address",
+		})
+	case endPrefix.Addr().Less(startPrefix.Addr()):
+		errors = append(errors, models.ValidationError{
+			Field:   "end_address",
+			Message: "must not be lower than start_address",
+		})
+	}
+
+	return errors
+}
+
+// coveringPrefixes returns at most two prefixes which together contain all
+// addresses of a range, used to narrow down the IP addresses looked up for its
+// utilization. A single covering prefix can be far wider than the range, up to
+// /0 for 127.255.255.255-128.0.0.0, so the range is split at the middle of that
+// prefix; each half is then at most twice the size of its part of the range.
+func coveringPrefixes(start, end netip.Addr) []netip.Prefix {
+	prefix := coveringPrefix(start, end)
+	if start == end {
+		return []netip.Prefix{prefix}
+	}
+
+	// start lies in the lower and end in the upper half of the covering prefix
+	middle := netip.PrefixFrom(end, prefix.Bits()+1).Masked().Addr()
+	return []netip.Prefix{coveringPrefix(start, middle.Prev()), coveringPrefix(middle, end)}
+}
+
+// coveringPrefix returns the smallest prefix containing both addresses of a range
+func coveringPrefix(start, end netip.Addr) netip.Prefix {
+	for bits := start.BitLen(); bits > 0; bits-- {
+		prefix := netip.PrefixFrom(start, bits).Masked()
+		if prefix.Contains(end) {
+			return prefix
+		}
+	}
+
+	return netip.PrefixFrom(start, 0).Masked()
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestIPRangeAvailableIPs(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/ipam/ip-ranges/3/available-ips/", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`[{"family": 4, "address": "10.0.0.100/24"}, {"family": 4, "address": "10.0.0.101/24"}]`))
+		case http.MethodPost:
+			var body []map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Len(t, body, 2)
+			assert.Equal(t, "dhcp", body[0]["status"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`[{"id": 1, "address": "10.0.0.100/24"}, {"id": 2, "address": "10.0.0.101/24"}]`))
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	available, err := client.ListAvailableIPsInRange(3, 2)
+	require.NoError(t, err)
+	assert.Len(t, available, 2)
+
+	addresses, err := client.AllocateIPsFromRange(3, 2, &AllocateIPInput{Status: IPAddressStatusDHCP})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.101/24", addresses[1].Address)
+}
+
+func TestGetIPRangeUtilization(t *testing.T) {
+	tests := []struct {
+		name         string
+		vrf          map[string]any
+		markUtilized bool
+		wantVRFID    string
+		wantUsed     int
+	}{
+		{name: "counts addresses within the range and VRF", vrf: map[string]any{"id": 7}, wantVRFID: "7", wantUsed: 2},
+		{name: "global range", wantVRFID: "null", wantUsed: 2},
+		{name: "marked utilized", vrf: map[string]any{"id": 7}, markUtilized: true, wantVRFID: "7", wantUsed: 101},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/api/ipam/ip-ranges/3/":
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"id": 3, "start_address": "10.0.0.100/24", "end_address": "10.0.0.200/24", "size": 101,
+						"vrf": tt.vrf, "mark_utilized": tt.markUtilized,
+					})
+				case "/api/ipam/ip-addresses/":
+					assert.Equal(t, []string{"10.0.0.96/27", "10.0.0.128/25"}, r.URL.Query()["parent"])
+					assert.Equal(t, tt.wantVRFID, r.URL.Query().Get("vrf_id"))
+					_ = json.NewEncoder(w).Encode(map[string]any{"count": 4, "results": []map[string]any{
+						{"id": 1, "address": "10.0.0.1/24", "vrf": tt.vrf},
+						{"id": 2, "address": "10.0.0.100/24", "vrf": tt.vrf},
+						{"id": 3, "address": "10.0.0.200/24", "vrf": tt.vrf},
+						{"id": 4, "address": "10.0.0.201/24", "vrf": tt.vrf},
+					}})
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			client := newTestClient(t, ts)
+
+			utilization, err := client.GetIPRangeUtilization(3)
+			require.NoError(t, err)
+			assert.Equal(t, 101, utilization.Size)
+			assert.Equal(t, tt.wantUsed, utilization.Used)
+			assert.Equal(t, 101-tt.wantUsed, utilization.Available())
+			assert.Len(t, utilization.IPAddresses, 2)
+			assert.InDelta(t, float64(tt.wantUsed)/101*100, utilization.Percent(), 0.001)
+		})
+	}
+}
+
+func TestIPRangeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		wantFields []string
+	}{
+		{name: "valid", start: "10.0.0.100/24", end: "10.0.0.200/24"},
+		{name: "missing mask", start: "10.0.0.100", end: "10.0.0.200/24", wantFields: []string{"start_address"}},
+		{name: "mixed families", start: "10.0.0.100/24", end: "2001:db8::1/64", wantFields: []string{"end_address"}},
+		{name: "mask mismatch", start: "10.0.0.100/24", end: "10.0.0.200/25", wantFields: []string{"end_address"}},
+		{name: "reversed", start: "10.0.0.200/24", end: "10.0.0.100/24", wantFields: []string{"end_address"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&CreateIPRangeInput{StartAddress: tt.start, EndAddress: tt.end}).Validate()
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErrors models.ValidationErrors
+			require.True(t, errors.As(err, &validationErrors))
+
+			var fields []string
+			for _, e := range validationErrors {
+				fields = append(fields, e.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestCoveringPrefix(t *testing.T) {
+	assert.Equal(t, "10.0.0.0/24", coveringPrefix(netip.MustParseAddr("10.0.0.100"), netip.MustParseAddr("10.0.0.200")).String())
+	assert.Equal(t, "10.0.0.0/21", coveringPrefix(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.7.254")).String())
+	assert.Equal(t, "10.0.0.5/32", coveringPrefix(netip.MustParseAddr("10.0.0.5"), netip.MustParseAddr("10.0.0.5")).String())
+}
+
+func TestCoveringPrefixes(t *testing.T) {
+	tests := []struct {
+		start, end string
+		want       []string
+	}{
+		{start: "10.0.0.5", end: "10.0.0.5", want: []string{"10.0.0.5/32"}},
+		{start: "10.0.0.100", end: "10.0.0.200", want: []string{"10.0.0.96/27", "10.0.0.128/25"}},
+		{start: "10.0.0.0", end: "10.0.0.255", want: []string{"10.0.0.0/25", "10.0.0.128/25"}},
+		{start: "127.255.255.255", end: "128.0.0.0", want: []string{"127.255.255.255/32", "128.0.0.0/32"}},
+		{start: "2001:db8::ff", end: "2001:db8::100", want: []string{"2001:db8::ff/128", "2001:db8::100/128"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, prefix := range coveringPrefixes(netip.MustParseAddr(tt.start), netip.MustParseAddr(tt.end)) {
+			got = append(got, prefix.String())
+		}
+		assert.Equal(t, tt.want, got, "%s-%s", tt.start, tt.end)
+	}
+}
@@ -23,12 +23,12 @@ type Location struct {
 
 // ListLocationsInput represents the input for listing locations
 type ListLocationsInput struct {
-	Name   string
-	Site   string
-	Parent string
-	Tag    string
-	Limit  int
-	Offset int
+	Name   string `query:"name__ic"`
+	Site   string `query:"site"`
+	Parent string `query:"parent"`
+	Tag    string `query:"tag"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
 }
 
 // CreateLocationInput represents the input for creating a location
@@ -120,18 +120,37 @@ type PatchLocationInput struct {
 
 // Validate validates the PatchLocationInput
 func (input *PatchLocationInput) Validate() error {
+	var errors models.ValidationErrors
+
 	if input.ID == 0 {
-		return &models.ValidationError{
+		errors = append(errors, models.ValidationError{
 			Field:   "id",
 			Message: "ID is required",
+		})
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
 	if input.Slug != nil {
 		if err := models.ValidateSlug(*input.Slug); err != nil {
-			return err
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
+	if input.Site != nil && *input.Site == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "site",
+			Message: "Site is required",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
 	return nil
 }
@@ -1,177 +1,88 @@
 package client
 
 import (
-	"fmt"
-	"net/http"
+	"context"
 )
 
-// ListLocations lists all locations
+// LocationResource is the typed resource for dcim/locations
+type LocationResource = Resource[Location, CreateLocationInput, UpdateLocationInput, PatchLocationInput, ListLocationsInput]
+
+// Locations returns the typed resource for dcim/locations
+func (c *Client) Locations() *LocationResource {
+	return NewResource[Location, CreateLocationInput, UpdateLocationInput, PatchLocationInput, ListLocationsInput](c, "dcim", "locations")
+}
+
+// ListLocations lists all locations matching the input criteria
 func (c *Client) ListLocations(input *ListLocationsInput) ([]Location, error) {
-	path := c.BuildPath("dcim", "locations")
-
-	// Build query parameters
-	params := map[string]string{}
-	if input.Name != "" {
-		params["name__ic"] = input.Name
-	}
-	if input.Site != "" {
-		params["site"] = input.Site
-	}
-	if input.Parent != "" {
-		params["parent"] = input.Parent
-	}
-	if input.Tag != "" {
-		params["tag"] = input.Tag
-	}
-	if input.Limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", input.Limit)
-	}
-	if input.Offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", input.Offset)
-	}
-
-	// Make request
-	var response Response
-	response.Results = make([]any, 0)
-	_, err := c.R().
-		SetQueryParams(params).
-		SetResult(&response).
-		Get(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error listing locations: %w", err)
-	}
-
-	// Convert results to []Location
-	locations := make([]Location, len(response.Results))
-	for i, result := range response.Results {
-		resultMap, ok := result.(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("unexpected result type at index %d", i)
-		}
-
-		// Create a new Location
-		var location Location
-		err := convertMapToStruct(resultMap, &location)
-		if err != nil {
-			return nil, fmt.Errorf("error converting map to struct at index %d: %w", i, err)
-		}
-
-		locations[i] = location
-	}
-
-	return locations, nil
+	return c.ListLocationsWithContext(context.Background(), input)
 }
 
-// GetLocation retrieves a single location by ID
-func (c *Client) GetLocation(id int) (*Location, error) {
-	path := c.BuildPath("dcim", "locations", fmt.Sprintf("%d", id))
+// ListLocationsWithContext lists all locations matching the input criteria using the provided context
+func (c *Client) ListLocationsWithContext(ctx context.Context, input *ListLocationsInput) ([]Location, error) {
+	return c.Locations().List(ctx, input)
+}
+
+// LocationsPager returns a pager over all locations matching the input criteria
+func (c *Client) LocationsPager(input *ListLocationsInput, opts *PagerOptions) *Pager[Location] {
+	return c.Locations().Pager(input, opts)
+}
 
-	var location Location
-	resp, err := c.R().
-		SetResult(&location).
-		Get(path)
+// ListAllLocations lists all locations matching the input criteria, following pagination until exhausted
+func (c *Client) ListAllLocations(input *ListLocationsInput, opts *PagerOptions) ([]Location, error) {
+	return c.ListAllLocationsWithContext(context.Background(), input, opts)
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("error getting location: %w", err)
-	}
+// ListAllLocationsWithContext lists all locations matching the input criteria, following pagination until exhausted, using the provided context
+func (c *Client) ListAllLocationsWithContext(ctx context.Context, input *ListLocationsInput, opts *PagerOptions) ([]Location, error) {
+	return c.Locations().ListAll(ctx, input, opts)
+}
 
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("location not found")
-	}
+// GetLocation retrieves a single location by ID
+func (c *Client) GetLocation(id int) (*Location, error) {
+	return c.GetLocationWithContext(context.Background(), id)
+}
 
-	return &location, nil
+// GetLocationWithContext retrieves a single location by ID using the provided context
+func (c *Client) GetLocationWithContext(ctx context.Context, id int) (*Location, error) {
+	return c.Locations().Get(ctx, id)
 }
 
 // CreateLocation creates a new location
 func (c *Client) CreateLocation(input *CreateLocationInput) (*Location, error) {
-	path := c.BuildPath("dcim", "locations")
-
-	var location Location
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&location).
-		Post(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating location: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.CreateLocationWithContext(context.Background(), input)
+}
 
-	return &location, nil
+// CreateLocationWithContext creates a new location using the provided context
+func (c *Client) CreateLocationWithContext(ctx context.Context, input *CreateLocationInput) (*Location, error) {
+	return c.Locations().Create(ctx, input)
 }
 
 // UpdateLocation updates an existing location
 func (c *Client) UpdateLocation(input *UpdateLocationInput) (*Location, error) {
-	path := c.BuildPath("dcim", "locations", fmt.Sprintf("%d", input.ID))
-
-	var location Location
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&location).
-		Put(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error updating location: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("location not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.UpdateLocationWithContext(context.Background(), input)
+}
 
-	return &location, nil
+// UpdateLocationWithContext updates an existing location using the provided context
+func (c *Client) UpdateLocationWithContext(ctx context.Context, input *UpdateLocationInput) (*Location, error) {
+	return c.Locations().Update(ctx, input.ID, input)
 }
 
 // PatchLocation patches an existing location
 func (c *Client) PatchLocation(input *PatchLocationInput) (*Location, error) {
-	path := c.BuildPath("dcim", "locations", fmt.Sprintf("%d", input.ID))
-
-	var location Location
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&location).
-		Patch(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error patching location: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("location not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.PatchLocationWithContext(context.Background(), input)
+}
 
-	return &location, nil
+// PatchLocationWithContext patches an existing location using the provided context
+func (c *Client) PatchLocationWithContext(ctx context.Context, input *PatchLocationInput) (*Location, error) {
+	return c.Locations().Patch(ctx, input.ID, input)
 }
 
 // DeleteLocation deletes a location
 func (c *Client) DeleteLocation(id int) error {
-	path := c.BuildPath("dcim", "locations", fmt.Sprintf("%d", id))
-
-	resp, err := c.R().
-		Delete(path)
-
-	if err != nil {
-		return fmt.Errorf("error deleting location: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return fmt.Errorf("location not found")
-	}
-
-	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.DeleteLocationWithContext(context.Background(), id)
+}
 
-	return nil
+// DeleteLocationWithContext deletes a location using the provided context
+func (c *Client) DeleteLocationWithContext(ctx context.Context, id int) error {
+	return c.Locations().Delete(ctx, id)
 }
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Manufacturer represents a Netbox manufacturer
+type Manufacturer struct {
+	ID                 int            `json:"id"`
+	URL                string         `json:"url"`
+	Display            string         `json:"display"`
+	Name               string         `json:"name"`
+	Slug               string         `json:"slug"`
+	Description        string         `json:"description,omitempty"`
+	Tags               []models.Tag   `json:"tags,omitempty"`
+	CustomFields       map[string]any `json:"custom_fields,omitempty"`
+	Created            string         `json:"created"`
+	LastUpdated        string         `json:"last_updated"`
+	DeviceTypeCount    int            `json:"devicetype_count"`
+	ModuleTypeCount    int            `json:"moduletype_count"`
+	InventoryItemCount int            `json:"inventoryitem_count"`
+	PlatformCount      int            `json:"platform_count"`
+}
+
+// CreateManufacturerInput represents the input for creating a manufacturer
+type CreateManufacturerInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateManufacturerInput
+func (input *CreateManufacturerInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateManufacturerInput represents the input for updating a manufacturer
+type UpdateManufacturerInput CreateManufacturerInput
+
+// Validate validates the UpdateManufacturerInput
+func (input *UpdateManufacturerInput) Validate() error {
+	return (*CreateManufacturerInput)(input).Validate()
+}
+
+// PatchManufacturerInput represents the input for patching a manufacturer
+type PatchManufacturerInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchManufacturerInput
+func (input *PatchManufacturerInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListManufacturersInput represents the input for listing manufacturers
+type ListManufacturersInput struct {
+	Query  string   `query:"q"`        // General search
+	Name   string   `query:"name__ic"` // Filter by name (case-insensitive partial match)
+	Slug   []string `query:"slug"`     // Filter by slug
+	Tag    []string `query:"tag"`      // Filter by tag slug
+	Limit  int      `query:"limit"`    // Number of results to return per page
+	Offset int      `query:"offset"`   // The initial index from which to return the results
+}
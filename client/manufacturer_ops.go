@@ -0,0 +1,9 @@
+package client
+
+// ManufacturerResource is the typed resource for dcim/manufacturers
+type ManufacturerResource = Resource[Manufacturer, CreateManufacturerInput, UpdateManufacturerInput, PatchManufacturerInput, ListManufacturersInput]
+
+// Manufacturers returns the typed resource for dcim/manufacturers
+func (c *Client) Manufacturers() *ManufacturerResource {
+	return NewResource[Manufacturer, CreateManufacturerInput, UpdateManufacturerInput, PatchManufacturerInput, ListManufacturersInput](c, "dcim", "manufacturers")
+}
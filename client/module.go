@@ -0,0 +1,151 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for modules
+const (
+	ModuleStatusOffline         = "offline"
+	ModuleStatusActive          = "active"
+	ModuleStatusPlanned         = "planned"
+	ModuleStatusStaged          = "staged"
+	ModuleStatusFailed          = "failed"
+	ModuleStatusDecommissioning = "decommissioning"
+)
+
+// moduleStatuses lists all valid module status values
+var moduleStatuses = []string{
+	ModuleStatusOffline,
+	ModuleStatusActive,
+	ModuleStatusPlanned,
+	ModuleStatusStaged,
+	ModuleStatusFailed,
+	ModuleStatusDecommissioning,
+}
+
+// Module represents a Netbox module, an instance of a module type installed
+// into a module bay of a device
+type Module struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Device       *Device        `json:"device"`
+	ModuleBay    *ModuleBay     `json:"module_bay"`
+	ModuleType   *ModuleType    `json:"module_type"`
+	Status       *Status        `json:"status"`
+	Serial       string         `json:"serial,omitempty"`
+	AssetTag     *string        `json:"asset_tag,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateModuleInput represents the input for installing a module into a
+// module bay. Netbox creates the components defined by the module type unless
+// ReplicateComponents is false; with AdoptComponents, existing components of
+// the device with matching names are assigned to the module instead.
+type CreateModuleInput struct {
+	Device              int                `json:"device"`
+	ModuleBay           int                `json:"module_bay"`
+	ModuleType          int                `json:"module_type"`
+	Status              string             `json:"status,omitempty"`
+	Serial              string             `json:"serial,omitempty"`
+	AssetTag            string             `json:"asset_tag,omitempty"`
+	ReplicateComponents *bool              `json:"replicate_components,omitempty"`
+	AdoptComponents     bool               `json:"adopt_components,omitempty"`
+	Description         string             `json:"description,omitempty"`
+	Comments            string             `json:"comments,omitempty"`
+	Tags                []models.TagCreate `json:"tags,omitempty"`
+	CustomFields        map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateModuleInput
+func (input *CreateModuleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Device == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device",
+			Message: "Device is required",
+		})
+	}
+
+	if input.ModuleBay == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "module_bay",
+			Message: "Module bay is required",
+		})
+	}
+
+	if input.ModuleType == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "module_type",
+			Message: "Module type is required",
+		})
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, moduleStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateModuleInput represents the input for updating a module. The component
+// flags only apply when a module is created.
+type UpdateModuleInput CreateModuleInput
+
+// Validate validates the UpdateModuleInput
+func (input *UpdateModuleInput) Validate() error {
+	return (*CreateModuleInput)(input).Validate()
+}
+
+// PatchModuleInput represents the input for patching a module
+type PatchModuleInput struct {
+	Device       *int                `json:"device,omitempty"`
+	ModuleBay    *int                `json:"module_bay,omitempty"`
+	ModuleType   *int                `json:"module_type,omitempty"`
+	Status       *string             `json:"status,omitempty"`
+	Serial       *string             `json:"serial,omitempty"`
+	AssetTag     *string             `json:"asset_tag,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchModuleInput
+func (input *PatchModuleInput) Validate() error {
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, moduleStatuses...); err != nil {
+			return models.ValidationErrors{*err.(*models.ValidationError)}
+		}
+	}
+
+	return nil
+}
+
+// ListModulesInput represents the input for listing modules
+type ListModulesInput struct {
+	Query          string   `query:"q"`               // General search
+	DeviceID       []int    `query:"device_id"`       // Filter by device ID
+	ModuleBayID    []int    `query:"module_bay_id"`   // Filter by module bay ID
+	ModuleTypeID   []int    `query:"module_type_id"`  // Filter by module type ID
+	ManufacturerID []int    `query:"manufacturer_id"` // Filter by manufacturer ID
+	Status         []string `query:"status"`          // Filter by status
+	Serial         []string `query:"serial"`          // Filter by serial number
+	AssetTag       []string `query:"asset_tag"`       // Filter by asset tag
+	Tag            []string `query:"tag"`             // Filter by tag slug
+	Limit          int      `query:"limit"`           // Number of results to return per page
+	Offset         int      `query:"offset"`          // The initial index from which to return the results
+}
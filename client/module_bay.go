@@ -0,0 +1,46 @@
+package client
+
+// ModuleBay represents a Netbox module bay, a slot in a device which holds a module
+type ModuleBay struct {
+	Component
+	InstalledModule *NestedObject `json:"installed_module,omitempty"`
+	Position        string        `json:"position,omitempty"`
+}
+
+// CreateModuleBayInput represents the input for creating a module bay
+type CreateModuleBayInput struct {
+	ComponentInput
+	Position string `json:"position,omitempty"`
+}
+
+// Validate validates the CreateModuleBayInput
+func (input *CreateModuleBayInput) Validate() error {
+	if errors := input.ComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateModuleBayInput represents the input for updating a module bay
+type UpdateModuleBayInput CreateModuleBayInput
+
+// Validate validates the UpdateModuleBayInput
+func (input *UpdateModuleBayInput) Validate() error {
+	return (*CreateModuleBayInput)(input).Validate()
+}
+
+// PatchModuleBayInput represents the input for patching a module bay
+type PatchModuleBayInput struct {
+	PatchComponentInput
+	Position *string `json:"position,omitempty"`
+}
+
+// Validate validates the PatchModuleBayInput
+func (input *PatchModuleBayInput) Validate() error {
+	if errors := input.PatchComponentInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
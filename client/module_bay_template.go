@@ -0,0 +1,45 @@
+package client
+
+// ModuleBayTemplate represents a Netbox module bay template
+type ModuleBayTemplate struct {
+	ComponentTemplate
+	Position string `json:"position,omitempty"`
+}
+
+// CreateModuleBayTemplateInput represents the input for creating a module bay template
+type CreateModuleBayTemplateInput struct {
+	ComponentTemplateInput
+	Position string `json:"position,omitempty"`
+}
+
+// Validate validates the CreateModuleBayTemplateInput
+func (input *CreateModuleBayTemplateInput) Validate() error {
+	if errors := input.ComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateModuleBayTemplateInput represents the input for updating a module bay template
+type UpdateModuleBayTemplateInput CreateModuleBayTemplateInput
+
+// Validate validates the UpdateModuleBayTemplateInput
+func (input *UpdateModuleBayTemplateInput) Validate() error {
+	return (*CreateModuleBayTemplateInput)(input).Validate()
+}
+
+// PatchModuleBayTemplateInput represents the input for patching a module bay template
+type PatchModuleBayTemplateInput struct {
+	PatchComponentTemplateInput
+	Position *string `json:"position,omitempty"`
+}
+
+// Validate validates the PatchModuleBayTemplateInput
+func (input *PatchModuleBayTemplateInput) Validate() error {
+	if errors := input.PatchComponentTemplateInput.validate(); len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package client
+
+// ModuleResource is the typed resource for dcim/modules
+type ModuleResource = Resource[Module, CreateModuleInput, UpdateModuleInput, PatchModuleInput, ListModulesInput]
+
+// Modules returns the typed resource for dcim/modules
+func (c *Client) Modules() *ModuleResource {
+	return NewResource[Module, CreateModuleInput, UpdateModuleInput, PatchModuleInput, ListModulesInput](c, "dcim", "modules")
+}
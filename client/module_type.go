@@ -0,0 +1,106 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// ModuleType represents a Netbox module type, a hardware model for modules
+// such as line cards and power supplies which are installed into module bays
+type ModuleType struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Manufacturer *Manufacturer  `json:"manufacturer"`
+	Model        string         `json:"model"`
+	PartNumber   string         `json:"part_number,omitempty"`
+	Airflow      *Choice        `json:"airflow,omitempty"`
+	Weight       *float64       `json:"weight,omitempty"`
+	WeightUnit   *Choice        `json:"weight_unit,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateModuleTypeInput represents the input for creating a module type
+type CreateModuleTypeInput struct {
+	Manufacturer int                `json:"manufacturer"`
+	Model        string             `json:"model"`
+	PartNumber   string             `json:"part_number,omitempty"`
+	Airflow      string             `json:"airflow,omitempty"`
+	Weight       *float64           `json:"weight,omitempty"`
+	WeightUnit   string             `json:"weight_unit,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateModuleTypeInput
+func (input *CreateModuleTypeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Manufacturer == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "manufacturer",
+			Message: "Manufacturer is required",
+		})
+	}
+
+	if err := models.ValidateRequired("model", input.Model); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateModuleTypeInput represents the input for updating a module type
+type UpdateModuleTypeInput CreateModuleTypeInput
+
+// Validate validates the UpdateModuleTypeInput
+func (input *UpdateModuleTypeInput) Validate() error {
+	return (*CreateModuleTypeInput)(input).Validate()
+}
+
+// PatchModuleTypeInput represents the input for patching a module type
+type PatchModuleTypeInput struct {
+	Manufacturer *int                `json:"manufacturer,omitempty"`
+	Model        *string             `json:"model,omitempty"`
+	PartNumber   *string             `json:"part_number,omitempty"`
+	Airflow      *string             `json:"airflow,omitempty"`
+	Weight       *float64            `json:"weight,omitempty"`
+	WeightUnit   *string             `json:"weight_unit,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchModuleTypeInput
+func (input *PatchModuleTypeInput) Validate() error {
+	if input.Model != nil {
+		if err := models.ValidateRequired("model", *input.Model); err != nil {
+			return models.ValidationErrors{*err.(*models.ValidationError)}
+		}
+	}
+
+	return nil
+}
+
+// ListModuleTypesInput represents the input for listing module types
+type ListModuleTypesInput struct {
+	Query          string   `query:"q"`               // General search
+	Model          []string `query:"model"`           // Filter by exact model name
+	PartNumber     []string `query:"part_number"`     // Filter by part number
+	ManufacturerID []int    `query:"manufacturer_id"` // Filter by manufacturer ID
+	Manufacturer   []string `query:"manufacturer"`    // Filter by manufacturer slug
+	Tag            []string `query:"tag"`             // Filter by tag slug
+	Limit          int      `query:"limit"`           // Number of results to return per page
+	Offset         int      `query:"offset"`          // The initial index from which to return the results
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PagerOptions configures how a Pager walks through a paginated list
+type PagerOptions struct {
+	PageSize int // Number of results to request per page, overrides the input's Limit
+	MaxItems int // Maximum number of results to return across all pages, 0 means no limit
+}
+
+// page represents a single typed page of a Netbox list response
+type page[T any] struct {
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []T     `json:"results"`
+}
+
+// Pager iterates through the pages of a Netbox list endpoint by following
+// the "next" links returned by the API
+type Pager[T any] struct {
+	client   *Client
+	path     string
+	params   url.Values
+	next     string
+	started  bool
+	done     bool
+	maxItems int
+	fetched  int
+	count    int
+	page     []T
+	err      error
+}
+
+// newPager creates a pager for the given list path and input
+func newPager[T any](c *Client, path string, input any, opts *PagerOptions) *Pager[T] {
+	params := encodeQuery(input)
+
+	p := &Pager[T]{
+		client: c,
+		path:   path,
+		params: params,
+	}
+
+	if opts != nil {
+		if opts.PageSize > 0 {
+			params.Set("limit", fmt.Sprintf("%d", opts.PageSize))
+		}
+		p.maxItems = opts.MaxItems
+	}
+
+	return p
+}
+
+// Next fetches the next page of results. It returns false once all pages
+// have been read, the MaxItems cap has been reached or an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	req := p.client.RWithContext(ctx)
+	target := p.next
+	if !p.started {
+		req.SetQueryParamsFromValues(p.params)
+		target = p.path
+		p.started = true
+	}
+
+	var response page[T]
+	resp, err := req.
+		SetResult(&response).
+		Get(target)
+
+	if err != nil {
+		p.err = fmt.Errorf("error listing page: %w", err)
+		return false
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		p.err = err
+		return false
+	}
+
+	p.count = response.Count
+	p.page = response.Results
+
+	if p.maxItems > 0 && p.fetched+len(p.page) >= p.maxItems {
+		p.page = p.page[:p.maxItems-p.fetched]
+		p.done = true
+	}
+	p.fetched += len(p.page)
+
+	if response.Next == nil || *response.Next == "" {
+		p.done = true
+	} else {
+		p.next = *response.Next
+	}
+
+	return true
+}
+
+// Page returns the results of the current page
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Count returns the total number of matching objects reported by Netbox
+func (p *Pager[T]) Count() int {
+	return p.count
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All reads every remaining page and returns the combined results
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	results := make([]T, 0)
+	for p.Next(ctx) {
+		results = append(results, p.page...)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return results, nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagingTestServer serves total sites, honouring limit and offset like Netbox does
+func newPagingTestServer(t *testing.T, total int, requests *int) *testServer {
+	var ts *testServer
+	ts = newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		assert.Equal(t, "/api/dcim/sites/", r.URL.Path)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit == 0 {
+			limit = 50
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		results := []map[string]any{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			results = append(results, map[string]any{"id": i + 1, "name": fmt.Sprintf("Site %d", i+1)})
+		}
+
+		var next *string
+		if offset+limit < total {
+			n := fmt.Sprintf("%s/api/dcim/sites/?limit=%d&offset=%d&status=active", ts.URL, limit, offset+limit)
+			next = &n
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"count":    total,
+			"next":     next,
+			"previous": nil,
+			"results":  results,
+		})
+		if err != nil {
+			t.Fatalf("failed to encode response body: %v", err)
+		}
+	})
+	return ts
+}
+
+func TestListAllSites(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		opts         *PagerOptions
+		wantItems    int
+		wantRequests int
+	}{
+		{
+			name:         "single page",
+			total:        10,
+			wantItems:    10,
+			wantRequests: 1,
+		},
+		{
+			name:         "follows next links",
+			total:        25,
+			opts:         &PagerOptions{PageSize: 10},
+			wantItems:    25,
+			wantRequests: 3,
+		},
+		{
+			name:         "max items cap",
+			total:        25,
+			opts:         &PagerOptions{PageSize: 10, MaxItems: 15},
+			wantItems:    15,
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			ts := newPagingTestServer(t, tt.total, &requests)
+
+			client := newTestClient(t, ts)
+
+			sites, err := client.ListAllSites(&ListSitesInput{Status: SiteStatusActive}, tt.opts)
+			require.NoError(t, err)
+			assert.Len(t, sites, tt.wantItems)
+			assert.Equal(t, tt.wantRequests, requests)
+			for i, site := range sites {
+				assert.Equal(t, i+1, site.ID)
+			}
+		})
+	}
+}
+
+func TestSitesPager(t *testing.T) {
+	requests := 0
+	ts := newPagingTestServer(t, 5, &requests)
+
+	client := newTestClient(t, ts)
+
+	pager := client.SitesPager(&ListSitesInput{Status: SiteStatusActive}, &PagerOptions{PageSize: 2})
+	var pages [][]Site
+	for pager.Next(context.Background()) {
+		pages = append(pages, pager.Page())
+	}
+	require.NoError(t, pager.Err())
+	assert.Len(t, pages, 3)
+	assert.Equal(t, 5, pager.Count())
+	assert.Len(t, pages[2], 1)
+}
+
+func TestEncodeQuery(t *testing.T) {
+	enabled := false
+	input := struct {
+		Name    string   `query:"name__ic"`
+		SiteID  []int    `query:"site_id"`
+		Enabled *bool    `query:"enabled"`
+		Limit   int      `query:"limit"`
+		Status  []string `query:"status"`
+		Ignored string
+	}{
+		Name:    "core",
+		SiteID:  []int{1, 2},
+		Enabled: &enabled,
+		Ignored: "ignored",
+	}
+
+	params := encodeQuery(&input)
+	assert.Equal(t, "core", params.Get("name__ic"))
+	assert.Equal(t, []string{"1", "2"}, params["site_id"])
+	assert.Equal(t, "false", params.Get("enabled"))
+	assert.NotContains(t, params, "limit")
+	assert.NotContains(t, params, "status")
+	assert.Len(t, params, 3)
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Platform represents a Netbox platform, the software running on a device or
+// virtual machine, such as a network operating system
+type Platform struct {
+	ID                  int            `json:"id"`
+	URL                 string         `json:"url"`
+	Display             string         `json:"display"`
+	Name                string         `json:"name"`
+	Slug                string         `json:"slug"`
+	Manufacturer        *Manufacturer  `json:"manufacturer,omitempty"`
+	ConfigTemplate      *NestedObject  `json:"config_template,omitempty"`
+	Description         string         `json:"description,omitempty"`
+	Tags                []models.Tag   `json:"tags,omitempty"`
+	CustomFields        map[string]any `json:"custom_fields,omitempty"`
+	Created             string         `json:"created"`
+	LastUpdated         string         `json:"last_updated"`
+	DeviceCount         int            `json:"device_count"`
+	VirtualMachineCount int            `json:"virtualmachine_count"`
+}
+
+// CreatePlatformInput represents the input for creating a platform
+type CreatePlatformInput struct {
+	Name           string             `json:"name"`
+	Slug           string             `json:"slug"`
+	Manufacturer   int                `json:"manufacturer,omitempty"`
+	ConfigTemplate int                `json:"config_template,omitempty"`
+	Description    string             `json:"description,omitempty"`
+	Tags           []models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreatePlatformInput
+func (input *CreatePlatformInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePlatformInput represents the input for updating a platform
+type UpdatePlatformInput CreatePlatformInput
+
+// Validate validates the UpdatePlatformInput
+func (input *UpdatePlatformInput) Validate() error {
+	return (*CreatePlatformInput)(input).Validate()
+}
+
+// PatchPlatformInput represents the input for patching a platform
+type PatchPlatformInput struct {
+	Name           *string             `json:"name,omitempty"`
+	Slug           *string             `json:"slug,omitempty"`
+	Manufacturer   *int                `json:"manufacturer,omitempty"`
+	ConfigTemplate *int                `json:"config_template,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Tags           *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchPlatformInput
+func (input *PatchPlatformInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListPlatformsInput represents the input for listing platforms
+type ListPlatformsInput struct {
+	Query            string   `query:"q"`                  // General search
+	Name             string   `query:"name__ic"`           // Filter by name (case-insensitive partial match)
+	Slug             []string `query:"slug"`               // Filter by slug
+	ManufacturerID   []int    `query:"manufacturer_id"`    // Filter by manufacturer ID
+	Manufacturer     []string `query:"manufacturer"`       // Filter by manufacturer slug
+	ConfigTemplateID []int    `query:"config_template_id"` // Filter by config template ID
+	Tag              []string `query:"tag"`                // Filter by tag slug
+	Limit            int      `query:"limit"`              // Number of results to return per page
+	Offset           int      `query:"offset"`             // The initial index from which to return the results
+}
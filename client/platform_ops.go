@@ -0,0 +1,9 @@
+package client
+
+// PlatformResource is the typed resource for dcim/platforms
+type PlatformResource = Resource[Platform, CreatePlatformInput, UpdatePlatformInput, PatchPlatformInput, ListPlatformsInput]
+
+// Platforms returns the typed resource for dcim/platforms
+func (c *Client) Platforms() *PlatformResource {
+	return NewResource[Platform, CreatePlatformInput, UpdatePlatformInput, PatchPlatformInput, ListPlatformsInput](c, "dcim", "platforms")
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// RearPort represents a Netbox rear port on a device, such as the trunk side
+// of a patch panel
+type RearPort struct {
+	Component
+	CableState
+	Type      *Choice `json:"type"`
+	Color     string  `json:"color,omitempty"`
+	Positions int     `json:"positions"`
+}
+
+// CreateRearPortInput represents the input for creating a rear port
+type CreateRearPortInput struct {
+	ComponentInput
+	Type          string `json:"type"`
+	Color         string `json:"color,omitempty"`
+	Positions     int    `json:"positions,omitempty"`
+	MarkConnected bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreateRearPortInput
+func (input *CreateRearPortInput) Validate() error {
+	errors := input.ComponentInput.validate()
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validatePortPosition("positions", input.Positions)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRearPortInput represents the input for updating a rear port
+type UpdateRearPortInput CreateRearPortInput
+
+// Validate validates the UpdateRearPortInput
+func (input *UpdateRearPortInput) Validate() error {
+	return (*CreateRearPortInput)(input).Validate()
+}
+
+// PatchRearPortInput represents the input for patching a rear port
+type PatchRearPortInput struct {
+	PatchComponentInput
+	Type          *string `json:"type,omitempty"`
+	Color         *string `json:"color,omitempty"`
+	Positions     *int    `json:"positions,omitempty"`
+	MarkConnected *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchRearPortInput
+func (input *PatchRearPortInput) Validate() error {
+	errors := input.PatchComponentInput.validate()
+
+	if input.Type != nil {
+		if err := models.ValidateRequired("type", *input.Type); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Positions != nil {
+		errors = append(errors, validatePortPosition("positions", *input.Positions)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// FrontPort represents a Netbox front port on a device, which maps onto a
+// position of one of the device's rear ports
+type FrontPort struct {
+	Component
+	CableState
+	Type             *Choice   `json:"type"`
+	Color            string    `json:"color,omitempty"`
+	RearPort         *RearPort `json:"rear_port"`
+	RearPortPosition int       `json:"rear_port_position"`
+}
+
+// CreateFrontPortInput represents the input for creating a front port
+type CreateFrontPortInput struct {
+	ComponentInput
+	Type             string `json:"type"`
+	Color            string `json:"color,omitempty"`
+	RearPort         int    `json:"rear_port"`
+	RearPortPosition int    `json:"rear_port_position,omitempty"`
+	MarkConnected    bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreateFrontPortInput
+func (input *CreateFrontPortInput) Validate() error {
+	errors := input.ComponentInput.validate()
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.RearPort == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rear_port",
+			Message: "Rear port is required",
+		})
+	}
+
+	errors = append(errors, validatePortPosition("rear_port_position", input.RearPortPosition)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateFrontPortInput represents the input for updating a front port
+type UpdateFrontPortInput CreateFrontPortInput
+
+// Validate validates the UpdateFrontPortInput
+func (input *UpdateFrontPortInput) Validate() error {
+	return (*CreateFrontPortInput)(input).Validate()
+}
+
+// PatchFrontPortInput represents the input for patching a front port
+type PatchFrontPortInput struct {
+	PatchComponentInput
+	Type             *string `json:"type,omitempty"`
+	Color            *string `json:"color,omitempty"`
+	RearPort         *int    `json:"rear_port,omitempty"`
+	RearPortPosition *int    `json:"rear_port_position,omitempty"`
+	MarkConnected    *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchFrontPortInput
+func (input *PatchFrontPortInput) Validate() error {
+	errors := input.PatchComponentInput.validate()
+
+	if input.Type != nil {
+		if err := models.ValidateRequired("type", *input.Type); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.RearPortPosition != nil {
+		errors = append(errors, validatePortPosition("rear_port_position", *input.RearPortPosition)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
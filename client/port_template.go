@@ -0,0 +1,171 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// RearPortTemplate represents a Netbox rear port template
+type RearPortTemplate struct {
+	ComponentTemplate
+	Type      *Choice `json:"type"`
+	Color     string  `json:"color,omitempty"`
+	Positions int     `json:"positions"`
+}
+
+// CreateRearPortTemplateInput represents the input for creating a rear port template
+type CreateRearPortTemplateInput struct {
+	ComponentTemplateInput
+	Type      string `json:"type"`
+	Color     string `json:"color,omitempty"`
+	Positions int    `json:"positions,omitempty"`
+}
+
+// Validate validates the CreateRearPortTemplateInput
+func (input *CreateRearPortTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validatePortPosition("positions", input.Positions)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRearPortTemplateInput represents the input for updating a rear port template
+type UpdateRearPortTemplateInput CreateRearPortTemplateInput
+
+// Validate validates the UpdateRearPortTemplateInput
+func (input *UpdateRearPortTemplateInput) Validate() error {
+	return (*CreateRearPortTemplateInput)(input).Validate()
+}
+
+// PatchRearPortTemplateInput represents the input for patching a rear port template
+type PatchRearPortTemplateInput struct {
+	PatchComponentTemplateInput
+	Type      *string `json:"type,omitempty"`
+	Color     *string `json:"color,omitempty"`
+	Positions *int    `json:"positions,omitempty"`
+}
+
+// Validate validates the PatchRearPortTemplateInput
+func (input *PatchRearPortTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.Type != nil {
+		if err := models.ValidateRequired("type", *input.Type); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Positions != nil {
+		errors = append(errors, validatePortPosition("positions", *input.Positions)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// FrontPortTemplate represents a Netbox front port template, which maps onto
+// a position of a rear port template
+type FrontPortTemplate struct {
+	ComponentTemplate
+	Type             *Choice           `json:"type"`
+	Color            string            `json:"color,omitempty"`
+	RearPort         *RearPortTemplate `json:"rear_port"`
+	RearPortPosition int               `json:"rear_port_position"`
+}
+
+// CreateFrontPortTemplateInput represents the input for creating a front port template
+type CreateFrontPortTemplateInput struct {
+	ComponentTemplateInput
+	Type             string `json:"type"`
+	Color            string `json:"color,omitempty"`
+	RearPort         int    `json:"rear_port"`
+	RearPortPosition int    `json:"rear_port_position,omitempty"`
+}
+
+// Validate validates the CreateFrontPortTemplateInput
+func (input *CreateFrontPortTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+
+	if err := models.ValidateRequired("type", input.Type); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.RearPort == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rear_port",
+			Message: "Rear port is required",
+		})
+	}
+
+	errors = append(errors, validatePortPosition("rear_port_position", input.RearPortPosition)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateFrontPortTemplateInput represents the input for updating a front port template
+type UpdateFrontPortTemplateInput CreateFrontPortTemplateInput
+
+// Validate validates the UpdateFrontPortTemplateInput
+func (input *UpdateFrontPortTemplateInput) Validate() error {
+	return (*CreateFrontPortTemplateInput)(input).Validate()
+}
+
+// PatchFrontPortTemplateInput represents the input for patching a front port template
+type PatchFrontPortTemplateInput struct {
+	PatchComponentTemplateInput
+	Type             *string `json:"type,omitempty"`
+	Color            *string `json:"color,omitempty"`
+	RearPort         *int    `json:"rear_port,omitempty"`
+	RearPortPosition *int    `json:"rear_port_position,omitempty"`
+}
+
+// Validate validates the PatchFrontPortTemplateInput
+func (input *PatchFrontPortTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.Type != nil {
+		if err := models.ValidateRequired("type", *input.Type); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.RearPortPosition != nil {
+		errors = append(errors, validatePortPosition("rear_port_position", *input.RearPortPosition)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validatePortPosition checks a rear port position count or a front port's
+// position on its rear port. Netbox allows up to 1024 positions; zero is
+// skipped and defaults to 1.
+func validatePortPosition(field string, position int) models.ValidationErrors {
+	if position == 0 {
+		return nil
+	}
+
+	if err := models.ValidateRange(field, float64(position), 1, 1024); err != nil {
+		return models.ValidationErrors{*err.(*models.ValidationError)}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"math"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for power feeds
+const (
+	PowerFeedStatusOffline = "offline"
+	PowerFeedStatusActive  = "active"
+	PowerFeedStatusPlanned = "planned"
+	PowerFeedStatusFailed  = "failed"
+)
+
+// powerFeedStatuses lists all valid power feed status values
+var powerFeedStatuses = []string{
+	PowerFeedStatusOffline,
+	PowerFeedStatusActive,
+	PowerFeedStatusPlanned,
+	PowerFeedStatusFailed,
+}
+
+// Valid power feed types
+const (
+	PowerFeedTypePrimary   = "primary"
+	PowerFeedTypeRedundant = "redundant"
+)
+
+// Valid power feed supplies
+const (
+	PowerFeedSupplyAC = "ac"
+	PowerFeedSupplyDC = "dc"
+)
+
+// Valid power feed phases
+const (
+	PowerFeedPhaseSingle = "single-phase"
+	PowerFeedPhaseThree  = "three-phase"
+)
+
+// PowerFeed represents a Netbox power feed, a circuit from a power panel
+// which is typically connected to a PDU in a rack. MaxUtilization is the
+// maximum permissible draw as a percentage of the feed's capacity.
+type PowerFeed struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	PowerPanel     *PowerPanel    `json:"power_panel"`
+	Rack           *Rack          `json:"rack,omitempty"`
+	Name           string         `json:"name"`
+	Status         *Status        `json:"status"`
+	Type           *Choice        `json:"type"`
+	Supply         *Choice        `json:"supply"`
+	Phase          *Choice        `json:"phase"`
+	Voltage        int            `json:"voltage"`
+	Amperage       int            `json:"amperage"`
+	MaxUtilization int            `json:"max_utilization"`
+	Tenant         *NestedObject  `json:"tenant,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	CableState
+	ConnectionState
+}
+
+// AvailablePower returns the usable power of the feed in watts, calculated as
+// Netbox does: voltage × amperage × max utilization, multiplied by √3 for
+// three-phase feeds
+func (f *PowerFeed) AvailablePower() int {
+	power := math.Abs(float64(f.Voltage)) * float64(f.Amperage) * float64(f.MaxUtilization) / 100
+	if f.Phase != nil && f.Phase.Value == PowerFeedPhaseThree {
+		power *= math.Sqrt(3)
+	}
+
+	return int(math.Round(power))
+}
+
+// CreatePowerFeedInput represents the input for creating a power feed. Netbox
+// defaults to an active, primary, single-phase 120V AC feed of 20A with 80%
+// maximum utilization.
+type CreatePowerFeedInput struct {
+	PowerPanel     int                `json:"power_panel"`
+	Rack           int                `json:"rack,omitempty"`
+	Name           string             `json:"name"`
+	Status         string             `json:"status,omitempty"`
+	Type           string             `json:"type,omitempty"`
+	Supply         string             `json:"supply,omitempty"`
+	Phase          string             `json:"phase,omitempty"`
+	Voltage        *int               `json:"voltage,omitempty"`
+	Amperage       *int               `json:"amperage,omitempty"`
+	MaxUtilization *int               `json:"max_utilization,omitempty"`
+	MarkConnected  bool               `json:"mark_connected,omitempty"`
+	Tenant         int                `json:"tenant,omitempty"`
+	Description    string             `json:"description,omitempty"`
+	Comments       string             `json:"comments,omitempty"`
+	Tags           []models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreatePowerFeedInput
+func (input *CreatePowerFeedInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.PowerPanel == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "power_panel",
+			Message: "Power panel is required",
+		})
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validatePowerFeedFields(&input.Status, &input.Type, &input.Supply, &input.Phase, input.Voltage, input.Amperage, input.MaxUtilization)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerFeedInput represents the input for updating a power feed
+type UpdatePowerFeedInput CreatePowerFeedInput
+
+// Validate validates the UpdatePowerFeedInput
+func (input *UpdatePowerFeedInput) Validate() error {
+	return (*CreatePowerFeedInput)(input).Validate()
+}
+
+// PatchPowerFeedInput represents the input for patching a power feed
+type PatchPowerFeedInput struct {
+	PowerPanel     *int                `json:"power_panel,omitempty"`
+	Rack           *int                `json:"rack,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	Status         *string             `json:"status,omitempty"`
+	Type           *string             `json:"type,omitempty"`
+	Supply         *string             `json:"supply,omitempty"`
+	Phase          *string             `json:"phase,omitempty"`
+	Voltage        *int                `json:"voltage,omitempty"`
+	Amperage       *int                `json:"amperage,omitempty"`
+	MaxUtilization *int                `json:"max_utilization,omitempty"`
+	MarkConnected  *bool               `json:"mark_connected,omitempty"`
+	Tenant         *int                `json:"tenant,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Comments       *string             `json:"comments,omitempty"`
+	Tags           *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchPowerFeedInput
+func (input *PatchPowerFeedInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	errors = append(errors, validatePowerFeedFields(input.Status, input.Type, input.Supply, input.Phase, input.Voltage, input.Amperage, input.MaxUtilization)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validatePowerFeedFields checks the electrical characteristics of a power
+// feed. Nil and empty values are skipped. Only DC feeds may have a negative
+// voltage, and DC feeds cannot be three-phase.
+func validatePowerFeedFields(status, feedType, supply, phase *string, voltage, amperage, maxUtilization *int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if status != nil && *status != "" {
+		if err := models.ValidateOneOf("status", *status, powerFeedStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if feedType != nil && *feedType != "" {
+		if err := models.ValidateOneOf("type", *feedType, PowerFeedTypePrimary, PowerFeedTypeRedundant); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if supply != nil && *supply != "" {
+		if err := models.ValidateOneOf("supply", *supply, PowerFeedSupplyAC, PowerFeedSupplyDC); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if phase != nil && *phase != "" {
+		if err := models.ValidateOneOf("phase", *phase, PowerFeedPhaseSingle, PowerFeedPhaseThree); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	isDC := supply != nil && *supply == PowerFeedSupplyDC
+
+	if isDC && phase != nil && *phase == PowerFeedPhaseThree {
+		errors = append(errors, models.ValidationError{
+			Field:   "phase",
+			Message: "DC feeds cannot be three-phase",
+		})
+	}
+
+	if voltage != nil && *voltage < 0 && !isDC {
+		errors = append(errors, models.ValidationError{
+			Field:   "voltage",
+			Message: "only DC feeds may have a negative voltage",
+		})
+	}
+
+	if voltage != nil && *voltage == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "voltage",
+			Message: "cannot be zero",
+		})
+	}
+
+	if amperage != nil && *amperage < 1 {
+		errors = append(errors, models.ValidationError{
+			Field:   "amperage",
+			Message: "must be at least 1",
+		})
+	}
+
+	if maxUtilization != nil {
+		if err := models.ValidateRange("max_utilization", float64(*maxUtilization), 1, 100); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	return errors
+}
+
+// ListPowerFeedsInput represents the input for listing power feeds
+type ListPowerFeedsInput struct {
+	Query        string   `query:"q"`              // General search
+	Name         string   `query:"name__ic"`       // Filter by name (case-insensitive partial match)
+	PowerPanelID []int    `query:"power_panel_id"` // Filter by power panel ID
+	RackID       []int    `query:"rack_id"`        // Filter by rack ID
+	SiteID       []int    `query:"site_id"`        // Filter by site ID
+	Status       []string `query:"status"`         // Filter by status
+	Type         []string `query:"type"`           // Filter by feed type
+	Supply       []string `query:"supply"`         // Filter by supply
+	Phase        []string `query:"phase"`          // Filter by phase
+	Voltage      []int    `query:"voltage"`        // Filter by voltage
+	Amperage     []int    `query:"amperage"`       // Filter by amperage
+	TenantID     []int    `query:"tenant_id"`      // Filter by tenant ID
+	Cabled       *bool    `query:"cabled"`         // Filter by whether a cable is attached
+	Connected    *bool    `query:"connected"`      // Filter by whether the feed is connected to a power port
+	Tag          []string `query:"tag"`            // Filter by tag slug
+	Limit        int      `query:"limit"`          // Number of results to return per page
+	Offset       int      `query:"offset"`         // The initial index from which to return the results
+}
+
+// PowerFeedUtilization summarizes the power drawn from a feed by the power
+// ports connected to it. All values are in watts.
+type PowerFeedUtilization struct {
+	Feed       *PowerFeed
+	Available  int         // Usable power of the feed, see PowerFeed.AvailablePower
+	Allocated  int         // Sum of the allocated draw of the connected power ports
+	Maximum    int         // Sum of the maximum draw of the connected power ports
+	PowerPorts []PowerPort // Power ports connected to the feed
+}
+
+// Remaining returns the power which can still be allocated from the feed
+func (u *PowerFeedUtilization) Remaining() int {
+	return u.Available - u.Allocated
+}
+
+// Percent returns the allocated power as a percentage of the available power
+func (u *PowerFeedUtilization) Percent() float64 {
+	if u.Available == 0 {
+		return 0
+	}
+
+	return float64(u.Allocated) / float64(u.Available) * 100
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"sort"
+)
+
+// PowerPanelResource is the typed resource for dcim/power-panels
+type PowerPanelResource = Resource[PowerPanel, CreatePowerPanelInput, UpdatePowerPanelInput, PatchPowerPanelInput, ListPowerPanelsInput]
+
+// PowerPanels returns the typed resource for dcim/power-panels
+func (c *Client) PowerPanels() *PowerPanelResource {
+	return NewResource[PowerPanel, CreatePowerPanelInput, UpdatePowerPanelInput, PatchPowerPanelInput, ListPowerPanelsInput](c, "dcim", "power-panels")
+}
+
+// PowerFeedResource is the typed resource for dcim/power-feeds
+type PowerFeedResource = Resource[PowerFeed, CreatePowerFeedInput, UpdatePowerFeedInput, PatchPowerFeedInput, ListPowerFeedsInput]
+
+// PowerFeeds returns the typed resource for dcim/power-feeds
+func (c *Client) PowerFeeds() *PowerFeedResource {
+	return NewResource[PowerFeed, CreatePowerFeedInput, UpdatePowerFeedInput, PatchPowerFeedInput, ListPowerFeedsInput](c, "dcim", "power-feeds")
+}
+
+// TracePowerFeed traces the cable path starting at a power feed, returning
+// each segment in order from the feed to the far endpoint
+func (c *Client) TracePowerFeed(powerFeedID int) ([]TraceHop, error) {
+	return c.TracePowerFeedWithContext(context.Background(), powerFeedID)
+}
+
+// TracePowerFeedWithContext traces the cable path starting at a power feed using the provided context
+func (c *Client) TracePowerFeedWithContext(ctx context.Context, powerFeedID int) ([]TraceHop, error) {
+	return c.trace(ctx, "power-feeds", powerFeedID)
+}
+
+// GetPowerFeedUtilization computes the power allocated from a feed against
+// the power it makes available
+func (c *Client) GetPowerFeedUtilization(powerFeedID int) (*PowerFeedUtilization, error) {
+	return c.GetPowerFeedUtilizationWithContext(context.Background(), powerFeedID)
+}
+
+// GetPowerFeedUtilizationWithContext computes the power allocated from a feed using the provided context
+func (c *Client) GetPowerFeedUtilizationWithContext(ctx context.Context, powerFeedID int) (*PowerFeedUtilization, error) {
+	feed, err := c.PowerFeeds().Get(ctx, powerFeedID)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization, err := c.powerFeedUtilization(ctx, []PowerFeed{*feed})
+	if err != nil {
+		return nil, err
+	}
+
+	return &utilization[0], nil
+}
+
+// ListPowerFeedUtilization computes the power utilization of every feed
+// matching the input criteria, for example all feeds of a rack
+func (c *Client) ListPowerFeedUtilization(input *ListPowerFeedsInput) ([]PowerFeedUtilization, error) {
+	return c.ListPowerFeedUtilizationWithContext(context.Background(), input)
+}
+
+// ListPowerFeedUtilizationWithContext computes the power utilization of every feed matching the input criteria using the provided context
+func (c *Client) ListPowerFeedUtilizationWithContext(ctx context.Context, input *ListPowerFeedsInput) ([]PowerFeedUtilization, error) {
+	feeds, err := c.PowerFeeds().ListAll(ctx, input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.powerFeedUtilization(ctx, feeds)
+}
+
+// powerFeedUtilization sums the draw of the power ports connected to each
+// feed. As in Netbox, a port with no draw of its own, such as a PDU inlet,
+// takes the sum of the ports connected to the outlets it feeds. Lookups are
+// batched across all feeds.
+func (c *Client) powerFeedUtilization(ctx context.Context, feeds []PowerFeed) ([]PowerFeedUtilization, error) {
+	var portIDs []int
+	for i := range feeds {
+		portIDs = append(portIDs, connectedPowerPortIDs(&feeds[i].ConnectionState)...)
+	}
+
+	ports, err := c.powerPortsByID(ctx, portIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var aggregateIDs []int
+	for id, port := range ports {
+		if port.AllocatedDraw == nil && port.MaximumDraw == nil {
+			aggregateIDs = append(aggregateIDs, id)
+		}
+	}
+	sort.Ints(aggregateIDs)
+
+	downstream := make(map[int][]int)
+	downstreamPorts := make(map[int]PowerPort)
+	if len(aggregateIDs) > 0 {
+		outlets, err := c.PowerOutlets().ListAll(ctx, &ListComponentsInput{PowerPortID: aggregateIDs}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var downstreamIDs []int
+		for i := range outlets {
+			if outlets[i].PowerPort == nil {
+				continue
+			}
+			ids := connectedPowerPortIDs(&outlets[i].ConnectionState)
+			downstream[outlets[i].PowerPort.ID] = append(downstream[outlets[i].PowerPort.ID], ids...)
+			downstreamIDs = append(downstreamIDs, ids...)
+		}
+
+		downstreamPorts, err = c.powerPortsByID(ctx, downstreamIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	utilization := make([]PowerFeedUtilization, 0, len(feeds))
+	for i := range feeds {
+		result := PowerFeedUtilization{
+			Feed:      &feeds[i],
+			Available: feeds[i].AvailablePower(),
+		}
+
+		for _, id := range connectedPowerPortIDs(&feeds[i].ConnectionState) {
+			port, ok := ports[id]
+			if !ok {
+				continue
+			}
+			result.PowerPorts = append(result.PowerPorts, port)
+
+			if port.AllocatedDraw != nil || port.MaximumDraw != nil {
+				result.Allocated += derefInt(port.AllocatedDraw)
+				result.Maximum += derefInt(port.MaximumDraw)
+				continue
+			}
+
+			for _, childID := range downstream[id] {
+				child := downstreamPorts[childID]
+				result.Allocated += derefInt(child.AllocatedDraw)
+				result.Maximum += derefInt(child.MaximumDraw)
+			}
+		}
+
+		utilization = append(utilization, result)
+	}
+
+	return utilization, nil
+}
+
+// powerPortsByID fetches power ports by ID, keyed by ID
+func (c *Client) powerPortsByID(ctx context.Context, ids []int) (map[int]PowerPort, error) {
+	ports := make(map[int]PowerPort, len(ids))
+	if len(ids) == 0 {
+		return ports, nil
+	}
+
+	list, err := c.PowerPorts().ListAll(ctx, &ListComponentsInput{ID: ids}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, port := range list {
+		ports[port.ID] = port
+	}
+
+	return ports, nil
+}
+
+// connectedPowerPortIDs returns the IDs of the power ports at the far end of a cable path
+func connectedPowerPortIDs(state *ConnectionState) []int {
+	if state.ConnectedEndpointsType != TerminationTypePowerPort {
+		return nil
+	}
+
+	ids := make([]int, 0, len(state.ConnectedEndpoints))
+	for _, endpoint := range state.ConnectedEndpoints {
+		ids = append(ids, endpoint.ID)
+	}
+
+	return ids
+}
+
+// derefInt returns the value of an optional integer, or zero
+func derefInt(value *int) int {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid feed legs for power outlets
+const (
+	FeedLegA = "A"
+	FeedLegB = "B"
+	FeedLegC = "C"
+)
+
+// PowerOutletTemplate represents a Netbox power outlet template
+type PowerOutletTemplate struct {
+	ComponentTemplate
+	Type      *Choice            `json:"type,omitempty"`
+	PowerPort *PowerPortTemplate `json:"power_port,omitempty"`
+	FeedLeg   *Choice            `json:"feed_leg,omitempty"`
+}
+
+// CreatePowerOutletTemplateInput represents the input for creating a power outlet template
+type CreatePowerOutletTemplateInput struct {
+	ComponentTemplateInput
+	Type      string `json:"type,omitempty"`
+	PowerPort int    `json:"power_port,omitempty"`
+	FeedLeg   string `json:"feed_leg,omitempty"`
+}
+
+// Validate validates the CreatePowerOutletTemplateInput
+func (input *CreatePowerOutletTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+
+	if input.FeedLeg != "" {
+		if err := models.ValidateOneOf("feed_leg", input.FeedLeg, FeedLegA, FeedLegB, FeedLegC); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerOutletTemplateInput represents the input for updating a power outlet template
+type UpdatePowerOutletTemplateInput CreatePowerOutletTemplateInput
+
+// Validate validates the UpdatePowerOutletTemplateInput
+func (input *UpdatePowerOutletTemplateInput) Validate() error {
+	return (*CreatePowerOutletTemplateInput)(input).Validate()
+}
+
+// PatchPowerOutletTemplateInput represents the input for patching a power outlet template
+type PatchPowerOutletTemplateInput struct {
+	PatchComponentTemplateInput
+	Type      *string `json:"type,omitempty"`
+	PowerPort *int    `json:"power_port,omitempty"`
+	FeedLeg   *string `json:"feed_leg,omitempty"`
+}
+
+// Validate validates the PatchPowerOutletTemplateInput
+func (input *PatchPowerOutletTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+
+	if input.FeedLeg != nil && *input.FeedLeg != "" {
+		if err := models.ValidateOneOf("feed_leg", *input.FeedLeg, FeedLegA, FeedLegB, FeedLegC); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
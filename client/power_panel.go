@@ -0,0 +1,99 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// PowerPanel represents a Netbox power panel, the distribution point from
+// which power feeds are run
+type PowerPanel struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Site           *Site          `json:"site"`
+	Location       *Location      `json:"location,omitempty"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	PowerFeedCount int            `json:"powerfeed_count"`
+}
+
+// CreatePowerPanelInput represents the input for creating a power panel
+type CreatePowerPanelInput struct {
+	Site         int                `json:"site"`
+	Location     int                `json:"location,omitempty"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreatePowerPanelInput
+func (input *CreatePowerPanelInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Site == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "site",
+			Message: "Site is required",
+		})
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerPanelInput represents the input for updating a power panel
+type UpdatePowerPanelInput CreatePowerPanelInput
+
+// Validate validates the UpdatePowerPanelInput
+func (input *UpdatePowerPanelInput) Validate() error {
+	return (*CreatePowerPanelInput)(input).Validate()
+}
+
+// PatchPowerPanelInput represents the input for patching a power panel
+type PatchPowerPanelInput struct {
+	Site         *int                `json:"site,omitempty"`
+	Location     *int                `json:"location,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchPowerPanelInput
+func (input *PatchPowerPanelInput) Validate() error {
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			return models.ValidationErrors{*err.(*models.ValidationError)}
+		}
+	}
+
+	return nil
+}
+
+// ListPowerPanelsInput represents the input for listing power panels
+type ListPowerPanelsInput struct {
+	Query      string   `query:"q"`           // General search
+	Name       string   `query:"name__ic"`    // Filter by name (case-insensitive partial match)
+	SiteID     []int    `query:"site_id"`     // Filter by site ID
+	Site       []string `query:"site"`        // Filter by site slug
+	LocationID []int    `query:"location_id"` // Filter by location ID, including child locations
+	RegionID   []int    `query:"region_id"`   // Filter by region ID
+	Tag        []string `query:"tag"`         // Filter by tag slug
+	Limit      int      `query:"limit"`       // Number of results to return per page
+	Offset     int      `query:"offset"`      // The initial index from which to return the results
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// PowerPort represents a Netbox power port on a device
+type PowerPort struct {
+	Component
+	CableState
+	ConnectionState
+	Type          *Choice `json:"type,omitempty"`
+	MaximumDraw   *int    `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int    `json:"allocated_draw,omitempty"`
+}
+
+// CreatePowerPortInput represents the input for creating a power port
+type CreatePowerPortInput struct {
+	ComponentInput
+	Type          string `json:"type,omitempty"`
+	MaximumDraw   *int   `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int   `json:"allocated_draw,omitempty"`
+	MarkConnected bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreatePowerPortInput
+func (input *CreatePowerPortInput) Validate() error {
+	errors := input.ComponentInput.validate()
+	errors = append(errors, validatePowerDraw(input.MaximumDraw, input.AllocatedDraw)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerPortInput represents the input for updating a power port
+type UpdatePowerPortInput CreatePowerPortInput
+
+// Validate validates the UpdatePowerPortInput
+func (input *UpdatePowerPortInput) Validate() error {
+	return (*CreatePowerPortInput)(input).Validate()
+}
+
+// PatchPowerPortInput represents the input for patching a power port
+type PatchPowerPortInput struct {
+	PatchComponentInput
+	Type          *string `json:"type,omitempty"`
+	MaximumDraw   *int    `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int    `json:"allocated_draw,omitempty"`
+	MarkConnected *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchPowerPortInput
+func (input *PatchPowerPortInput) Validate() error {
+	errors := input.PatchComponentInput.validate()
+	errors = append(errors, validatePowerDraw(input.MaximumDraw, input.AllocatedDraw)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// PowerOutlet represents a Netbox power outlet on a device, optionally fed
+// by one of the device's power ports
+type PowerOutlet struct {
+	Component
+	CableState
+	ConnectionState
+	Type      *Choice    `json:"type,omitempty"`
+	PowerPort *PowerPort `json:"power_port,omitempty"`
+	FeedLeg   *Choice    `json:"feed_leg,omitempty"`
+}
+
+// CreatePowerOutletInput represents the input for creating a power outlet
+type CreatePowerOutletInput struct {
+	ComponentInput
+	Type          string `json:"type,omitempty"`
+	PowerPort     int    `json:"power_port,omitempty"`
+	FeedLeg       string `json:"feed_leg,omitempty"`
+	MarkConnected bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the CreatePowerOutletInput
+func (input *CreatePowerOutletInput) Validate() error {
+	errors := input.ComponentInput.validate()
+
+	if input.FeedLeg != "" {
+		if err := models.ValidateOneOf("feed_leg", input.FeedLeg, FeedLegA, FeedLegB, FeedLegC); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerOutletInput represents the input for updating a power outlet
+type UpdatePowerOutletInput CreatePowerOutletInput
+
+// Validate validates the UpdatePowerOutletInput
+func (input *UpdatePowerOutletInput) Validate() error {
+	return (*CreatePowerOutletInput)(input).Validate()
+}
+
+// PatchPowerOutletInput represents the input for patching a power outlet
+type PatchPowerOutletInput struct {
+	PatchComponentInput
+	Type          *string `json:"type,omitempty"`
+	PowerPort     *int    `json:"power_port,omitempty"`
+	FeedLeg       *string `json:"feed_leg,omitempty"`
+	MarkConnected *bool   `json:"mark_connected,omitempty"`
+}
+
+// Validate validates the PatchPowerOutletInput
+func (input *PatchPowerOutletInput) Validate() error {
+	errors := input.PatchComponentInput.validate()
+
+	if input.FeedLeg != nil && *input.FeedLeg != "" {
+		if err := models.ValidateOneOf("feed_leg", *input.FeedLeg, FeedLegA, FeedLegB, FeedLegC); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
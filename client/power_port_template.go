@@ -0,0 +1,90 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// PowerPortTemplate represents a Netbox power port template
+type PowerPortTemplate struct {
+	ComponentTemplate
+	Type          *Choice `json:"type,omitempty"`
+	MaximumDraw   *int    `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int    `json:"allocated_draw,omitempty"`
+}
+
+// CreatePowerPortTemplateInput represents the input for creating a power port template
+type CreatePowerPortTemplateInput struct {
+	ComponentTemplateInput
+	Type          string `json:"type,omitempty"`
+	MaximumDraw   *int   `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int   `json:"allocated_draw,omitempty"`
+}
+
+// Validate validates the CreatePowerPortTemplateInput
+func (input *CreatePowerPortTemplateInput) Validate() error {
+	errors := input.ComponentTemplateInput.validate()
+	errors = append(errors, validatePowerDraw(input.MaximumDraw, input.AllocatedDraw)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePowerPortTemplateInput represents the input for updating a power port template
+type UpdatePowerPortTemplateInput CreatePowerPortTemplateInput
+
+// Validate validates the UpdatePowerPortTemplateInput
+func (input *UpdatePowerPortTemplateInput) Validate() error {
+	return (*CreatePowerPortTemplateInput)(input).Validate()
+}
+
+// PatchPowerPortTemplateInput represents the input for patching a power port template
+type PatchPowerPortTemplateInput struct {
+	PatchComponentTemplateInput
+	Type          *string `json:"type,omitempty"`
+	MaximumDraw   *int    `json:"maximum_draw,omitempty"`
+	AllocatedDraw *int    `json:"allocated_draw,omitempty"`
+}
+
+// Validate validates the PatchPowerPortTemplateInput
+func (input *PatchPowerPortTemplateInput) Validate() error {
+	errors := input.PatchComponentTemplateInput.validate()
+	errors = append(errors, validatePowerDraw(input.MaximumDraw, input.AllocatedDraw)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validatePowerDraw checks the maximum and allocated draw of a power port, in
+// watts. The allocated draw cannot exceed the maximum. Nil values are skipped.
+func validatePowerDraw(maximum, allocated *int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if maximum != nil && *maximum < 1 {
+		errors = append(errors, models.ValidationError{
+			Field:   "maximum_draw",
+			Message: "must be at least 1",
+		})
+	}
+
+	if allocated != nil && *allocated < 1 {
+		errors = append(errors, models.ValidationError{
+			Field:   "allocated_draw",
+			Message: "must be at least 1",
+		})
+	}
+
+	if maximum != nil && allocated != nil && *allocated > *maximum {
+		errors = append(errors, models.ValidationError{
+			Field:   "allocated_draw",
+			Message: "cannot exceed the maximum draw",
+		})
+	}
+
+	return errors
+}
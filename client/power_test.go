@@ -0,0 +1,120 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerFeedAvailablePower(t *testing.T) {
+	single := &PowerFeed{Voltage: 120, Amperage: 20, MaxUtilization: 80, Phase: &Choice{Value: PowerFeedPhaseSingle}}
+	assert.Equal(t, 1920, single.AvailablePower())
+
+	three := &PowerFeed{Voltage: 208, Amperage: 30, MaxUtilization: 80, Phase: &Choice{Value: PowerFeedPhaseThree}}
+	assert.Equal(t, 8646, three.AvailablePower())
+
+	dc := &PowerFeed{Voltage: -48, Amperage: 10, MaxUtilization: 100, Phase: &Choice{Value: PowerFeedPhaseSingle}}
+	assert.Equal(t, 480, dc.AvailablePower())
+}
+
+func TestListPowerFeedUtilization(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/api/dcim/power-feeds/":
+			assert.Equal(t, "7", query.Get("rack_id"))
+			_, _ = w.Write([]byte(`{"count": 2, "results": [
+				{"id": 1, "name": "A", "voltage": 208, "amperage": 30, "max_utilization": 80, "phase": {"value": "three-phase"},
+				 "cable": {"id": 50, "url": "http://netbox/api/dcim/cables/50/", "display": "#50"}, "cable_end": "A", "_occupied": true,
+				 "connected_endpoints": [{"id": 10, "url": "http://netbox/api/dcim/power-ports/10/", "display": "PDU-A inlet",
+					"device": {"id": 3, "name": "pdu-a"}, "name": "PDU-A inlet",
+					"cable": {"id": 50, "url": "http://netbox/api/dcim/cables/50/", "display": "#50"}, "_occupied": true}],
+				 "connected_endpoints_type": "dcim.powerport", "connected_endpoints_reachable": true},
+				{"id": 2, "name": "B", "voltage": 120, "amperage": 20, "max_utilization": 80, "phase": {"value": "single-phase"},
+				 "cable": {"id": 51, "url": "http://netbox/api/dcim/cables/51/", "display": "#51"}, "cable_end": "A", "_occupied": true,
+				 "connected_endpoints": [{"id": 11, "url": "http://netbox/api/dcim/power-ports/11/", "display": "PDU-B inlet",
+					"device": {"id": 4, "name": "pdu-b"}, "name": "PDU-B inlet",
+					"cable": {"id": 51, "url": "http://netbox/api/dcim/cables/51/", "display": "#51"}, "_occupied": true}],
+				 "connected_endpoints_type": "dcim.powerport", "connected_endpoints_reachable": true}
+			]}`))
+		case "/api/dcim/power-ports/":
+			switch {
+			case assert.ObjectsAreEqual([]string{"10", "11"}, query["id"]):
+				_, _ = w.Write([]byte(`{"count": 2, "results": [
+					{"id": 10, "name": "PDU-A inlet"},
+					{"id": 11, "name": "PDU-B inlet", "allocated_draw": 500, "maximum_draw": 800}
+				]}`))
+			case assert.ObjectsAreEqual([]string{"20", "21"}, query["id"]):
+				_, _ = w.Write([]byte(`{"count": 2, "results": [
+					{"id": 20, "name": "PSU1", "allocated_draw": 300, "maximum_draw": 400},
+					{"id": 21, "name": "PSU1", "allocated_draw": 200, "maximum_draw": 250}
+				]}`))
+			default:
+				t.Errorf("unexpected power port query %v", query)
+			}
+		case "/api/dcim/power-outlets/":
+			assert.Equal(t, []string{"10"}, query["power_port_id"])
+			_, _ = w.Write([]byte(`{"count": 3, "results": [
+				{"id": 30, "name": "out1", "device": {"id": 3, "name": "pdu-a"}, "power_port": {"id": 10},
+				 "cable": {"id": 60, "url": "http://netbox/api/dcim/cables/60/", "display": "#60"}, "_occupied": true,
+				 "connected_endpoints": [{"id": 20, "url": "http://netbox/api/dcim/power-ports/20/", "display": "PSU1",
+					"device": {"id": 5, "name": "srv1"}, "name": "PSU1",
+					"cable": {"id": 60, "url": "http://netbox/api/dcim/cables/60/", "display": "#60"}, "_occupied": true}],
+				 "connected_endpoints_type": "dcim.powerport", "connected_endpoints_reachable": true},
+				{"id": 31, "name": "out2", "device": {"id": 3, "name": "pdu-a"}, "power_port": {"id": 10},
+				 "cable": {"id": 61, "url": "http://netbox/api/dcim/cables/61/", "display": "#61"}, "_occupied": true,
+				 "connected_endpoints": [{"id": 21, "url": "http://netbox/api/dcim/power-ports/21/", "display": "PSU1",
+					"device": {"id": 6, "name": "srv2"}, "name": "PSU1",
+					"cable": {"id": 61, "url": "http://netbox/api/dcim/cables/61/", "display": "#61"}, "_occupied": true}],
+				 "connected_endpoints_type": "dcim.powerport", "connected_endpoints_reachable": true},
+				{"id": 32, "name": "out3", "device": {"id": 3, "name": "pdu-a"}, "power_port": {"id": 10}}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	utilization, err := client.ListPowerFeedUtilization(&ListPowerFeedsInput{RackID: []int{7}})
+	require.NoError(t, err)
+	require.Len(t, utilization, 2)
+
+	assert.Equal(t, "A", utilization[0].Feed.Name)
+	assert.Equal(t, 8646, utilization[0].Available)
+	assert.Equal(t, 500, utilization[0].Allocated)
+	assert.Equal(t, 650, utilization[0].Maximum)
+	require.Len(t, utilization[0].PowerPorts, 1)
+	assert.Equal(t, 10, utilization[0].PowerPorts[0].ID)
+	require.NotNil(t, utilization[0].Feed.Cable)
+	assert.Equal(t, 50, utilization[0].Feed.Cable.ID)
+
+	assert.Equal(t, 1920, utilization[1].Available)
+	assert.Equal(t, 500, utilization[1].Allocated)
+	assert.Equal(t, 1420, utilization[1].Remaining())
+	assert.InDelta(t, 26.04, utilization[1].Percent(), 0.01)
+}
+
+func TestCreatePowerFeedInputValidate(t *testing.T) {
+	voltage, amperage, utilization := -48, 60, 90
+
+	assert.NoError(t, (&CreatePowerFeedInput{
+		PowerPanel:     1,
+		Name:           "DC-A",
+		Supply:         PowerFeedSupplyDC,
+		Voltage:        &voltage,
+		Amperage:       &amperage,
+		MaxUtilization: &utilization,
+	}).Validate())
+
+	assert.Error(t, (&CreatePowerFeedInput{PowerPanel: 1, Name: "A", Voltage: &voltage}).Validate())
+	assert.Error(t, (&CreatePowerFeedInput{PowerPanel: 1, Name: "A", Supply: PowerFeedSupplyDC, Phase: PowerFeedPhaseThree}).Validate())
+	assert.Error(t, (&CreatePowerFeedInput{Name: "A"}).Validate())
+
+	over := 120
+	assert.Error(t, (&CreatePowerFeedInput{PowerPanel: 1, Name: "A", MaxUtilization: &over}).Validate())
+}
@@ -0,0 +1,238 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for prefixes
+const (
+	PrefixStatusContainer  = "container"
+	PrefixStatusActive     = "active"
+	PrefixStatusReserved   = "reserved"
+	PrefixStatusDeprecated = "deprecated"
+)
+
+// prefixStatuses lists all valid prefix status values
+var prefixStatuses = []string{
+	PrefixStatusContainer,
+	PrefixStatusActive,
+	PrefixStatusReserved,
+	PrefixStatusDeprecated,
+}
+
+// AddressFamily represents the IP address family of a prefix or address
+type AddressFamily struct {
+	Value int    `json:"value"`
+	Label string `json:"label"`
+}
+
+// Prefix represents a Netbox IP prefix
+type Prefix struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Family       *AddressFamily `json:"family"`
+	Prefix       string         `json:"prefix"`
+	Site         *Site          `json:"site,omitempty"`
+	VRF          *NestedObject  `json:"vrf,omitempty"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	VLAN         *NestedObject  `json:"vlan,omitempty"`
+	Status       *Status        `json:"status"`
+	Role         *NestedObject  `json:"role,omitempty"`
+	IsPool       bool           `json:"is_pool"`
+	MarkUtilized bool           `json:"mark_utilized"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	Children     int            `json:"children"`
+	Depth        int            `json:"_depth"`
+}
+
+// CreatePrefixInput represents the input for creating a prefix
+type CreatePrefixInput struct {
+	Prefix       string             `json:"prefix"`
+	Site         int                `json:"site,omitempty"`
+	VRF          int                `json:"vrf,omitempty"`
+	Tenant       int                `json:"tenant,omitempty"`
+	VLAN         int                `json:"vlan,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Role         int                `json:"role,omitempty"`
+	IsPool       bool               `json:"is_pool,omitempty"`
+	MarkUtilized bool               `json:"mark_utilized,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreatePrefixInput
+func (input *CreatePrefixInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidatePrefix("prefix", input.Prefix); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, prefixStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdatePrefixInput represents the input for updating a prefix
+type UpdatePrefixInput CreatePrefixInput
+
+// Validate validates the UpdatePrefixInput
+func (input *UpdatePrefixInput) Validate() error {
+	return (*CreatePrefixInput)(input).Validate()
+}
+
+// PatchPrefixInput represents the input for patching a prefix
+type PatchPrefixInput struct {
+	Prefix       *string             `json:"prefix,omitempty"`
+	Site         *int                `json:"site,omitempty"`
+	VRF          *int                `json:"vrf,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	VLAN         *int                `json:"vlan,omitempty"`
+	Status       *string             `json:"status,omitempty"`
+	Role         *int                `json:"role,omitempty"`
+	IsPool       *bool               `json:"is_pool,omitempty"`
+	MarkUtilized *bool               `json:"mark_utilized,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchPrefixInput
+func (input *PatchPrefixInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Prefix != nil {
+		if err := models.ValidatePrefix("prefix", *input.Prefix); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, prefixStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListPrefixesInput represents the input for listing prefixes. Slice fields
+// match any of the given values.
+type ListPrefixesInput struct {
+	Query         string   `query:"q"`              // General search
+	Prefix        []string `query:"prefix"`         // Filter by exact prefix
+	Within        string   `query:"within"`         // Filter by prefixes within the given prefix
+	WithinInclude string   `query:"within_include"` // Filter by prefixes within and including the given prefix
+	Contains      string   `query:"contains"`       // Filter by prefixes containing the given prefix or address
+	Family        int      `query:"family"`         // Filter by address family (4 or 6)
+	MaskLength    []int    `query:"mask_length"`    // Filter by mask length
+	SiteID        []int    `query:"site_id"`        // Filter by site ID
+	VRFID         []int    `query:"vrf_id"`         // Filter by VRF ID
+	TenantID      []int    `query:"tenant_id"`      // Filter by tenant ID
+	VLANID        []int    `query:"vlan_id"`        // Filter by VLAN ID
+	RoleID        []int    `query:"role_id"`        // Filter by role ID
+	Status        []string `query:"status"`         // Filter by status
+	IsPool        *bool    `query:"is_pool"`        // Filter by pool flag
+	Tag           []string `query:"tag"`            // Filter by tag slug
+	Limit         int      `query:"limit"`          // Number of results to return per page
+	Offset        int      `query:"offset"`         // The initial index from which to return the results
+}
+
+// AvailablePrefix represents an unallocated prefix within a parent prefix
+type AvailablePrefix struct {
+	Family int           `json:"family"`
+	Prefix string        `json:"prefix"`
+	VRF    *NestedObject `json:"vrf,omitempty"`
+}
+
+// AvailableIP represents an unallocated IP address within a prefix or IP range
+type AvailableIP struct {
+	Family  int           `json:"family"`
+	Address string        `json:"address"`
+	VRF     *NestedObject `json:"vrf,omitempty"`
+}
+
+// AllocatePrefixInput represents the input for allocating a child prefix of a
+// given length from a parent prefix. The VRF is inherited from the parent.
+type AllocatePrefixInput struct {
+	PrefixLength int                `json:"prefix_length"`
+	Site         int                `json:"site,omitempty"`
+	Tenant       int                `json:"tenant,omitempty"`
+	VLAN         int                `json:"vlan,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Role         int                `json:"role,omitempty"`
+	IsPool       bool               `json:"is_pool,omitempty"`
+	MarkUtilized bool               `json:"mark_utilized,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the AllocatePrefixInput
+func (input *AllocatePrefixInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRange("prefix_length", float64(input.PrefixLength), 1, 128); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, prefixStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// AllocateIPInput represents the input for allocating IP addresses from a
+// prefix or IP range. The address and VRF are assigned by Netbox.
+type AllocateIPInput struct {
+	Status             string             `json:"status,omitempty"`
+	Role               string             `json:"role,omitempty"`
+	Tenant             int                `json:"tenant,omitempty"`
+	AssignedObjectType string             `json:"assigned_object_type,omitempty"`
+	AssignedObjectID   int                `json:"assigned_object_id,omitempty"`
+	DNSName            string             `json:"dns_name,omitempty"`
+	Description        string             `json:"description,omitempty"`
+	Comments           string             `json:"comments,omitempty"`
+	Tags               []models.TagCreate `json:"tags,omitempty"`
+	CustomFields       map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the AllocateIPInput
+func (input *AllocateIPInput) Validate() error {
+	errors := validateIPAddressFields(input.Status, input.Role, input.AssignedObjectType, input.AssignedObjectID != 0)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
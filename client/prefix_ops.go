@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// PrefixResource is the typed resource for ipam/prefixes
+type PrefixResource = Resource[Prefix, CreatePrefixInput, UpdatePrefixInput, PatchPrefixInput, ListPrefixesInput]
+
+// Prefixes returns the typed resource for ipam/prefixes
+func (c *Client) Prefixes() *PrefixResource {
+	return NewResource[Prefix, CreatePrefixInput, UpdatePrefixInput, PatchPrefixInput, ListPrefixesInput](c, "ipam", "prefixes")
+}
+
+// ListAvailablePrefixes lists the unallocated child prefixes of a prefix
+func (c *Client) ListAvailablePrefixes(prefixID int) ([]AvailablePrefix, error) {
+	return c.ListAvailablePrefixesWithContext(context.Background(), prefixID)
+}
+
+// ListAvailablePrefixesWithContext lists the unallocated child prefixes of a prefix using the provided context
+func (c *Client) ListAvailablePrefixesWithContext(ctx context.Context, prefixID int) ([]AvailablePrefix, error) {
+	path := c.BuildPath("ipam", "prefixes", fmt.Sprintf("%d", prefixID), "available-prefixes")
+
+	available := make([]AvailablePrefix, 0)
+	resp, err := c.RWithContext(ctx).
+		SetResult(&available).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing available prefixes: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return available, nil
+}
+
+// AllocatePrefix allocates the first available child prefix of the requested length from a prefix
+func (c *Client) AllocatePrefix(prefixID int, input *AllocatePrefixInput) (*Prefix, error) {
+	return c.AllocatePrefixWithContext(context.Background(), prefixID, input)
+}
+
+// AllocatePrefixWithContext allocates the first available child prefix of the requested length from a prefix using the provided context
+func (c *Client) AllocatePrefixWithContext(ctx context.Context, prefixID int, input *AllocatePrefixInput) (*Prefix, error) {
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	path := c.BuildPath("ipam", "prefixes", fmt.Sprintf("%d", prefixID), "available-prefixes")
+
+	var prefix Prefix
+	resp, err := c.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&prefix).
+		Post(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error allocating prefix: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &prefix, nil
+}
+
+// ListAvailableIPsInPrefix lists up to limit unallocated IP addresses in a
+// prefix. A limit of 0 uses the server's default.
+func (c *Client) ListAvailableIPsInPrefix(prefixID int, limit int) ([]AvailableIP, error) {
+	return c.ListAvailableIPsInPrefixWithContext(context.Background(), prefixID, limit)
+}
+
+// ListAvailableIPsInPrefixWithContext lists up to limit unallocated IP addresses in a prefix using the provided context
+func (c *Client) ListAvailableIPsInPrefixWithContext(ctx context.Context, prefixID int, limit int) ([]AvailableIP, error) {
+	return c.listAvailableIPs(ctx, c.BuildPath("ipam", "prefixes", fmt.Sprintf("%d", prefixID), "available-ips"), limit)
+}
+
+// AllocateIPsFromPrefix atomically allocates count IP addresses from a prefix.
+// Either all addresses are created or none are.
+func (c *Client) AllocateIPsFromPrefix(prefixID int, count int, input *AllocateIPInput) ([]IPAddress, error) {
+	return c.AllocateIPsFromPrefixWithContext(context.Background(), prefixID, count, input)
+}
+
+// AllocateIPsFromPrefixWithContext atomically allocates count IP addresses from a prefix using the provided context
+func (c *Client) AllocateIPsFromPrefixWithContext(ctx context.Context, prefixID int, count int, input *AllocateIPInput) ([]IPAddress, error) {
+	return c.allocateIPs(ctx, c.BuildPath("ipam", "prefixes", fmt.Sprintf("%d", prefixID), "available-ips"), count, input)
+}
+
+// listAvailableIPs lists unallocated IP addresses from an available-ips endpoint
+func (c *Client) listAvailableIPs(ctx context.Context, path string, limit int) ([]AvailableIP, error) {
+	req := c.RWithContext(ctx)
+	if limit > 0 {
+		req.SetQueryParam("limit", fmt.Sprintf("%d", limit))
+	}
+
+	available := make([]AvailableIP, 0)
+	resp, err := req.
+		SetResult(&available).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing available IPs: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return available, nil
+}
+
+// allocateIPs allocates count IP addresses from an available-ips endpoint in a single request
+func (c *Client) allocateIPs(ctx context.Context, path string, count int, input *AllocateIPInput) ([]IPAddress, error) {
+	if count < 1 {
+		return nil, &models.ValidationError{
+			Field:   "count",
+			Message: "must be at least 1",
+		}
+	}
+
+	if input == nil {
+		input = &AllocateIPInput{}
+	}
+
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	body := make([]*AllocateIPInput, count)
+	for i := range body {
+		body[i] = input
+	}
+
+	var addresses []IPAddress
+	resp, err := c.RWithContext(ctx).
+		SetBody(body).
+		SetResult(&addresses).
+		Post(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error allocating IPs: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
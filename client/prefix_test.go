@@ -0,0 +1,73 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateIPsFromPrefix(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/ipam/prefixes/3/available-ips/", r.URL.Path)
+
+		var body []map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body, 2)
+		assert.Equal(t, "provisioning", body[0]["description"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`[
+			{"id": 1, "address": "10.0.0.1/24", "family": {"value": 4, "label": "IPv4"}, "status": {"value": "active"}},
+			{"id": 2, "address": "10.0.0.2/24", "family": {"value": 4, "label": "IPv4"}, "status": {"value": "active"}}
+		]`))
+	})
+
+	client := newTestClient(t, ts)
+
+	addresses, err := client.AllocateIPsFromPrefix(3, 2, &AllocateIPInput{Description: "provisioning"})
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+	assert.Equal(t, "10.0.0.1/24", addresses[0].Address)
+	assert.Equal(t, 4, addresses[1].Family.Value)
+
+	_, err = client.AllocateIPsFromPrefix(3, 0, nil)
+	assert.True(t, IsValidation(err))
+}
+
+func TestAllocatePrefix(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/ipam/prefixes/3/available-prefixes/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[{"family": 4, "prefix": "10.0.1.0/24"}, {"family": 4, "prefix": "10.0.2.0/23"}]`))
+			return
+		}
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(26), body["prefix_length"])
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 9, "prefix": "10.0.1.0/26", "family": {"value": 4, "label": "IPv4"}}`))
+	})
+
+	client := newTestClient(t, ts)
+
+	available, err := client.ListAvailablePrefixes(3)
+	require.NoError(t, err)
+	require.Len(t, available, 2)
+	assert.Equal(t, "10.0.2.0/23", available[1].Prefix)
+
+	prefix, err := client.AllocatePrefix(3, &AllocatePrefixInput{PrefixLength: 26, Status: PrefixStatusActive})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.0/26", prefix.Prefix)
+
+	_, err = client.AllocatePrefix(3, &AllocatePrefixInput{PrefixLength: 0})
+	assert.True(t, IsValidation(err))
+}
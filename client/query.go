@@ -0,0 +1,79 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// encodeQuery builds query parameters from the `query` struct tags of a list input.
+// Zero values are skipped, pointers are sent when non-nil and slices are sent
+// as repeated parameters. A bool field tagged with the "null" option, e.g.
+// `query:"vrf_id,null"`, sends the parameter as "null" when true, which
+// Netbox uses to match objects where the field is not set.
+func encodeQuery(input any) url.Values {
+	params := url.Values{}
+
+	v := reflect.ValueOf(input)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return params
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return params
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, option, _ := strings.Cut(t.Field(i).Tag.Get("query"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if option == "null" {
+			if field := v.Field(i); field.Kind() == reflect.Bool && field.Bool() {
+				params.Add(name, "null")
+			}
+			continue
+		}
+		addQueryValue(params, name, v.Field(i), false)
+	}
+
+	return params
+}
+
+// addQueryValue adds a single field value to the query parameters
+func addQueryValue(params url.Values, name string, v reflect.Value, explicit bool) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			addQueryValue(params, name, v.Elem(), true)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			addQueryValue(params, name, v.Index(i), true)
+		}
+	case reflect.String:
+		if explicit || v.String() != "" {
+			params.Add(name, v.String())
+		}
+	case reflect.Bool:
+		if explicit || v.Bool() {
+			params.Add(name, fmt.Sprintf("%t", v.Bool()))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if explicit || v.Int() > 0 {
+			params.Add(name, fmt.Sprintf("%d", v.Int()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if explicit || v.Uint() > 0 {
+			params.Add(name, fmt.Sprintf("%d", v.Uint()))
+		}
+	case reflect.Float32, reflect.Float64:
+		if explicit || v.Float() != 0 {
+			params.Add(name, fmt.Sprintf("%g", v.Float()))
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for racks
+const (
+	RackStatusReserved   = "reserved"
+	RackStatusAvailable  = "available"
+	RackStatusPlanned    = "planned"
+	RackStatusActive     = "active"
+	RackStatusDeprecated = "deprecated"
+)
+
+// rackStatuses lists all valid rack status values
+var rackStatuses = []string{
+	RackStatusReserved,
+	RackStatusAvailable,
+	RackStatusPlanned,
+	RackStatusActive,
+	RackStatusDeprecated,
+}
+
+// Rack represents a Netbox rack
+type Rack struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Name           string         `json:"name"`
+	FacilityID     *string        `json:"facility_id,omitempty"`
+	Site           *Site          `json:"site"`
+	Location       *Location      `json:"location,omitempty"`
+	Tenant         *NestedObject  `json:"tenant,omitempty"`
+	Status         *Status        `json:"status"`
+	Role           *RackRole      `json:"role,omitempty"`
+	RackType       *RackType      `json:"rack_type,omitempty"` // Requires Netbox 4.1 or later
+	Serial         string         `json:"serial,omitempty"`
+	AssetTag       *string        `json:"asset_tag,omitempty"`
+	FormFactor     *Choice        `json:"form_factor,omitempty"`
+	Width          *IntChoice     `json:"width,omitempty"`
+	UHeight        int            `json:"u_height"`
+	StartingUnit   int            `json:"starting_unit"`
+	DescUnits      bool           `json:"desc_units"`
+	OuterWidth     *int           `json:"outer_width,omitempty"`
+	OuterDepth     *int           `json:"outer_depth,omitempty"`
+	OuterUnit      *Choice        `json:"outer_unit,omitempty"`
+	Weight         *float64       `json:"weight,omitempty"`
+	MaxWeight      *int           `json:"max_weight,omitempty"`
+	WeightUnit     *Choice        `json:"weight_unit,omitempty"`
+	MountingDepth  *int           `json:"mounting_depth,omitempty"`
+	Airflow        *Choice        `json:"airflow,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	DeviceCount    int            `json:"device_count"`
+	PowerFeedCount int            `json:"powerfeed_count"`
+}
+
+// CreateRackInput represents the input for creating a rack
+type CreateRackInput struct {
+	Name          string             `json:"name"`
+	FacilityID    string             `json:"facility_id,omitempty"`
+	Site          int                `json:"site"`
+	Location      int                `json:"location,omitempty"`
+	Tenant        int                `json:"tenant,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	Role          int                `json:"role,omitempty"`
+	RackType      int                `json:"rack_type,omitempty"` // Requires Netbox 4.1 or later
+	Serial        string             `json:"serial,omitempty"`
+	AssetTag      string             `json:"asset_tag,omitempty"`
+	FormFactor    string             `json:"form_factor,omitempty"`
+	Width         int                `json:"width,omitempty"`
+	UHeight       int                `json:"u_height,omitempty"`
+	StartingUnit  int                `json:"starting_unit,omitempty"`
+	DescUnits     bool               `json:"desc_units,omitempty"`
+	OuterWidth    *int               `json:"outer_width,omitempty"`
+	OuterDepth    *int               `json:"outer_depth,omitempty"`
+	OuterUnit     string             `json:"outer_unit,omitempty"`
+	Weight        *float64           `json:"weight,omitempty"`
+	MaxWeight     *int               `json:"max_weight,omitempty"`
+	WeightUnit    string             `json:"weight_unit,omitempty"`
+	MountingDepth *int               `json:"mounting_depth,omitempty"`
+	Airflow       string             `json:"airflow,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Comments      string             `json:"comments,omitempty"`
+	Tags          []models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRackInput
+func (input *CreateRackInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Site == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "site",
+			Message: "Site is required",
+		})
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, rackStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.FormFactor != "" {
+		if err := models.ValidateOneOf("form_factor", input.FormFactor, rackFormFactors...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	errors = append(errors, validateRackDimensions(input.Width, input.UHeight)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRackInput represents the input for updating a rack
+type UpdateRackInput CreateRackInput
+
+// Validate validates the UpdateRackInput
+func (input *UpdateRackInput) Validate() error {
+	return (*CreateRackInput)(input).Validate()
+}
+
+// PatchRackInput represents the input for patching a rack
+type PatchRackInput struct {
+	Name          *string             `json:"name,omitempty"`
+	FacilityID    *string             `json:"facility_id,omitempty"`
+	Site          *int                `json:"site,omitempty"`
+	Location      *int                `json:"location,omitempty"`
+	Tenant        *int                `json:"tenant,omitempty"`
+	Status        *string             `json:"status,omitempty"`
+	Role          *int                `json:"role,omitempty"`
+	RackType      *int                `json:"rack_type,omitempty"` // Requires Netbox 4.1 or later
+	Serial        *string             `json:"serial,omitempty"`
+	AssetTag      *string             `json:"asset_tag,omitempty"`
+	FormFactor    *string             `json:"form_factor,omitempty"`
+	Width         *int                `json:"width,omitempty"`
+	UHeight       *int                `json:"u_height,omitempty"`
+	StartingUnit  *int                `json:"starting_unit,omitempty"`
+	DescUnits     *bool               `json:"desc_units,omitempty"`
+	OuterWidth    *int                `json:"outer_width,omitempty"`
+	OuterDepth    *int                `json:"outer_depth,omitempty"`
+	OuterUnit     *string             `json:"outer_unit,omitempty"`
+	Weight        *float64            `json:"weight,omitempty"`
+	MaxWeight     *int                `json:"max_weight,omitempty"`
+	WeightUnit    *string             `json:"weight_unit,omitempty"`
+	MountingDepth *int                `json:"mounting_depth,omitempty"`
+	Airflow       *string             `json:"airflow,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Comments      *string             `json:"comments,omitempty"`
+	Tags          *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRackInput
+func (input *PatchRackInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, rackStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.FormFactor != nil {
+		if err := models.ValidateOneOf("form_factor", *input.FormFactor, rackFormFactors...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	var width, uHeight int
+	if input.Width != nil {
+		width = *input.Width
+	}
+	if input.UHeight != nil {
+		uHeight = *input.UHeight
+	}
+	errors = append(errors, validateRackDimensions(width, uHeight)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRacksInput represents the input for listing racks. Slice fields match
+// any of the given values.
+type ListRacksInput struct {
+	Query      string   `query:"q"`            // General search
+	Name       string   `query:"name__ic"`     // Filter by name (case-insensitive partial match)
+	FacilityID []string `query:"facility_id"`  // Filter by facility ID
+	SiteID     []int    `query:"site_id"`      // Filter by site ID
+	Site       []string `query:"site"`         // Filter by site slug
+	LocationID []int    `query:"location_id"`  // Filter by location ID, including child locations
+	TenantID   []int    `query:"tenant_id"`    // Filter by tenant ID
+	RoleID     []int    `query:"role_id"`      // Filter by rack role ID
+	RackTypeID []int    `query:"rack_type_id"` // Filter by rack type ID (Netbox 4.1 or later)
+	Status     []string `query:"status"`       // Filter by status
+	Serial     []string `query:"serial"`       // Filter by serial number
+	AssetTag   []string `query:"asset_tag"`    // Filter by asset tag
+	Tag        []string `query:"tag"`          // Filter by tag slug
+	Limit      int      `query:"limit"`        // Number of results to return per page
+	Offset     int      `query:"offset"`       // The initial index from which to return the results
+}
+
+// RackUnit represents a single unit in a rack elevation
+type RackUnit struct {
+	ID       float64 `json:"id"` // Unit number; half units are used for devices mounted at .5 positions
+	Name     string  `json:"name"`
+	Face     *Choice `json:"face"`
+	Device   *Device `json:"device,omitempty"`
+	Occupied bool    `json:"occupied"`
+	Display  string  `json:"display"`
+}
+
+// RackElevationInput represents the input for retrieving a rack elevation
+type RackElevationInput struct {
+	Face          string `query:"face"`           // Rack face to render, front (default) or rear
+	Exclude       int    `query:"exclude"`        // ID of a device to exclude from the elevation
+	ExpandDevices *bool  `query:"expand_devices"` // Whether devices occupying multiple units are expanded to every unit
+	IncludeImages *bool  `query:"include_images"` // Whether device type images are included in SVG renderings
+	UnitWidth     int    `query:"unit_width"`     // Width of a rack unit in SVG renderings, in pixels
+	UnitHeight    int    `query:"unit_height"`    // Height of a rack unit in SVG renderings, in pixels
+	LegendWidth   int    `query:"legend_width"`   // Width of the unit legend in SVG renderings, in pixels
+	Query         string `query:"q"`              // Filter units by name
+}
+
+// FreeRackUnits returns the unit numbers of an elevation that are not
+// occupied by any device
+func FreeRackUnits(units []RackUnit) []float64 {
+	free := make([]float64, 0)
+	for _, unit := range units {
+		if !unit.Occupied {
+			free = append(free, unit.ID)
+		}
+	}
+	return free
+}
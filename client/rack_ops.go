@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RackResource is the typed resource for dcim/racks
+type RackResource = Resource[Rack, CreateRackInput, UpdateRackInput, PatchRackInput, ListRacksInput]
+
+// Racks returns the typed resource for dcim/racks
+func (c *Client) Racks() *RackResource {
+	return NewResource[Rack, CreateRackInput, UpdateRackInput, PatchRackInput, ListRacksInput](c, "dcim", "racks")
+}
+
+// RackRoleResource is the typed resource for dcim/rack-roles
+type RackRoleResource = Resource[RackRole, CreateRackRoleInput, UpdateRackRoleInput, PatchRackRoleInput, ListRackRolesInput]
+
+// RackRoles returns the typed resource for dcim/rack-roles
+func (c *Client) RackRoles() *RackRoleResource {
+	return NewResource[RackRole, CreateRackRoleInput, UpdateRackRoleInput, PatchRackRoleInput, ListRackRolesInput](c, "dcim", "rack-roles")
+}
+
+// RackTypeResource is the typed resource for dcim/rack-types
+type RackTypeResource = Resource[RackType, CreateRackTypeInput, UpdateRackTypeInput, PatchRackTypeInput, ListRackTypesInput]
+
+// RackTypes returns the typed resource for dcim/rack-types, which requires
+// Netbox 4.1 or later
+func (c *Client) RackTypes() *RackTypeResource {
+	return NewResource[RackType, CreateRackTypeInput, UpdateRackTypeInput, PatchRackTypeInput, ListRackTypesInput](c, "dcim", "rack-types")
+}
+
+// RackReservationResource is the typed resource for dcim/rack-reservations
+type RackReservationResource = Resource[RackReservation, CreateRackReservationInput, UpdateRackReservationInput, PatchRackReservationInput, ListRackReservationsInput]
+
+// RackReservations returns the typed resource for dcim/rack-reservations
+func (c *Client) RackReservations() *RackReservationResource {
+	return NewResource[RackReservation, CreateRackReservationInput, UpdateRackReservationInput, PatchRackReservationInput, ListRackReservationsInput](c, "dcim", "rack-reservations")
+}
+
+// GetRackElevation retrieves the units of one face of a rack along with the
+// devices occupying them, following pagination until every unit is read
+func (c *Client) GetRackElevation(rackID int, input *RackElevationInput) ([]RackUnit, error) {
+	return c.GetRackElevationWithContext(context.Background(), rackID, input)
+}
+
+// GetRackElevationWithContext retrieves the units of one face of a rack using the provided context
+func (c *Client) GetRackElevationWithContext(ctx context.Context, rackID int, input *RackElevationInput) ([]RackUnit, error) {
+	path := c.BuildPath("dcim", "racks", fmt.Sprintf("%d", rackID), "elevation")
+
+	pager := newPager[RackUnit](c, path, input, nil)
+	pager.params.Set("render", "json")
+
+	return pager.All(ctx)
+}
+
+// GetRackElevationSVG retrieves the SVG rendering of one face of a rack
+func (c *Client) GetRackElevationSVG(rackID int, input *RackElevationInput) (string, error) {
+	return c.GetRackElevationSVGWithContext(context.Background(), rackID, input)
+}
+
+// GetRackElevationSVGWithContext retrieves the SVG rendering of one face of a rack using the provided context
+func (c *Client) GetRackElevationSVGWithContext(ctx context.Context, rackID int, input *RackElevationInput) (string, error) {
+	path := c.BuildPath("dcim", "racks", fmt.Sprintf("%d", rackID), "elevation")
+
+	params := encodeQuery(input)
+	params.Set("render", "svg")
+
+	resp, err := c.RWithContext(ctx).
+		SetQueryParamsFromValues(params).
+		Get(path)
+
+	if err != nil {
+		return "", fmt.Errorf("error getting rack elevation: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return "", err
+	}
+
+	return resp.String(), nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// RackReservationUser represents the user who made a rack reservation
+type RackReservationUser struct {
+	ID       int    `json:"id"`
+	URL      string `json:"url"`
+	Display  string `json:"display"`
+	Username string `json:"username"`
+}
+
+// RackReservation represents a reservation of units in a Netbox rack
+type RackReservation struct {
+	ID           int                  `json:"id"`
+	URL          string               `json:"url"`
+	Display      string               `json:"display"`
+	Rack         *Rack                `json:"rack"`
+	Units        []int                `json:"units"`
+	User         *RackReservationUser `json:"user"`
+	Tenant       *NestedObject        `json:"tenant,omitempty"`
+	Description  string               `json:"description"`
+	Comments     string               `json:"comments,omitempty"`
+	Tags         []models.Tag         `json:"tags,omitempty"`
+	CustomFields map[string]any       `json:"custom_fields,omitempty"`
+	Created      string               `json:"created"`
+	LastUpdated  string               `json:"last_updated"`
+}
+
+// CreateRackReservationInput represents the input for creating a rack reservation
+type CreateRackReservationInput struct {
+	Rack         int                `json:"rack"`
+	Units        []int              `json:"units"`
+	User         int                `json:"user"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Description  string             `json:"description"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRackReservationInput
+func (input *CreateRackReservationInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Rack == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "rack",
+			Message: "Rack is required",
+		})
+	}
+
+	if len(input.Units) == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "units",
+			Message: "cannot be empty",
+		})
+	}
+
+	if input.User == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "user",
+			Message: "User is required",
+		})
+	}
+
+	if err := models.ValidateRequired("description", input.Description); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRackReservationInput represents the input for updating a rack reservation
+type UpdateRackReservationInput CreateRackReservationInput
+
+// Validate validates the UpdateRackReservationInput
+func (input *UpdateRackReservationInput) Validate() error {
+	return (*CreateRackReservationInput)(input).Validate()
+}
+
+// PatchRackReservationInput represents the input for patching a rack reservation
+type PatchRackReservationInput struct {
+	Rack         *int                `json:"rack,omitempty"`
+	Units        *[]int              `json:"units,omitempty"`
+	User         *int                `json:"user,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRackReservationInput
+func (input *PatchRackReservationInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Units != nil && len(*input.Units) == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "units",
+			Message: "cannot be empty",
+		})
+	}
+
+	if input.Description != nil {
+		if err := models.ValidateRequired("description", *input.Description); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRackReservationsInput represents the input for listing rack reservations
+type ListRackReservationsInput struct {
+	Query      string   `query:"q"`           // General search
+	RackID     []int    `query:"rack_id"`     // Filter by rack ID
+	SiteID     []int    `query:"site_id"`     // Filter by site ID
+	LocationID []int    `query:"location_id"` // Filter by location ID
+	UserID     []int    `query:"user_id"`     // Filter by user ID
+	TenantID   []int    `query:"tenant_id"`   // Filter by tenant ID
+	Unit       *int     `query:"unit"`        // Filter by reserved unit number
+	Tag        []string `query:"tag"`         // Filter by tag slug
+	Limit      int      `query:"limit"`       // Number of results to return per page
+	Offset     int      `query:"offset"`      // The initial index from which to return the results
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// RackRole represents a Netbox rack role
+type RackRole struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Slug         string         `json:"slug"`
+	Color        string         `json:"color,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	RackCount    int            `json:"rack_count"`
+}
+
+// CreateRackRoleInput represents the input for creating a rack role
+type CreateRackRoleInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	Color        string             `json:"color,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRackRoleInput
+func (input *CreateRackRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Color != "" {
+		if err := models.ValidateColor("color", input.Color); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRackRoleInput represents the input for updating a rack role
+type UpdateRackRoleInput CreateRackRoleInput
+
+// Validate validates the UpdateRackRoleInput
+func (input *UpdateRackRoleInput) Validate() error {
+	return (*CreateRackRoleInput)(input).Validate()
+}
+
+// PatchRackRoleInput represents the input for patching a rack role
+type PatchRackRoleInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	Color        *string             `json:"color,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRackRoleInput
+func (input *PatchRackRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Color != nil && *input.Color != "" {
+		if err := models.ValidateColor("color", *input.Color); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRackRolesInput represents the input for listing rack roles
+type ListRackRolesInput struct {
+	Name   string   `query:"name__ic"` // Filter by name (case-insensitive partial match)
+	Slug   []string `query:"slug"`     // Filter by slug
+	Color  []string `query:"color"`    // Filter by color
+	Tag    []string `query:"tag"`      // Filter by tag slug
+	Limit  int      `query:"limit"`    // Number of results to return per page
+	Offset int      `query:"offset"`   // The initial index from which to return the results
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRackElevation(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/racks/8/elevation/", r.URL.Path)
+		assert.Equal(t, RackFaceRear, r.URL.Query().Get("face"))
+
+		switch r.URL.Query().Get("render") {
+		case "svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			_, _ = w.Write([]byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+		case "json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"count": 4, "next": null, "previous": null, "results": [
+				{"id": 4, "name": "U4", "face": {"value": "rear"}, "device": null, "occupied": false},
+				{"id": 3, "name": "U3", "face": {"value": "rear"}, "device": {"id": 1, "name": "sw01"}, "occupied": true},
+				{"id": 2, "name": "U2", "face": {"value": "rear"}, "device": {"id": 1, "name": "sw01"}, "occupied": true},
+				{"id": 1, "name": "U1", "face": {"value": "rear"}, "device": null, "occupied": false}
+			]}`))
+		default:
+			t.Errorf("unexpected render %q", r.URL.Query().Get("render"))
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	units, err := client.GetRackElevation(8, &RackElevationInput{Face: RackFaceRear})
+	require.NoError(t, err)
+	require.Len(t, units, 4)
+	assert.Equal(t, "sw01", units[1].Device.Name)
+	assert.Equal(t, []float64{4, 1}, FreeRackUnits(units))
+
+	svg, err := client.GetRackElevationSVG(8, &RackElevationInput{Face: RackFaceRear})
+	require.NoError(t, err)
+	assert.Contains(t, svg, "<svg")
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid rack form factors
+const (
+	RackFormFactor2PostFrame          = "2-post-frame"
+	RackFormFactor4PostFrame          = "4-post-frame"
+	RackFormFactor4PostCabinet        = "4-post-cabinet"
+	RackFormFactorWallFrame           = "wall-frame"
+	RackFormFactorWallFrameVertical   = "wall-frame-vertical"
+	RackFormFactorWallCabinet         = "wall-cabinet"
+	RackFormFactorWallCabinetVertical = "wall-cabinet-vertical"
+)
+
+// rackFormFactors lists all valid rack form factors
+var rackFormFactors = []string{
+	RackFormFactor2PostFrame,
+	RackFormFactor4PostFrame,
+	RackFormFactor4PostCabinet,
+	RackFormFactorWallFrame,
+	RackFormFactorWallFrameVertical,
+	RackFormFactorWallCabinet,
+	RackFormFactorWallCabinetVertical,
+}
+
+// Valid rack rail-to-rail widths, in inches
+const (
+	RackWidth10 = 10
+	RackWidth19 = 19
+	RackWidth21 = 21
+	RackWidth23 = 23
+)
+
+// RackType represents a Netbox rack type, a reusable rack model. Rack types
+// were added in Netbox 4.1 and are not available on earlier versions.
+type RackType struct {
+	ID            int            `json:"id"`
+	URL           string         `json:"url"`
+	Display       string         `json:"display"`
+	Manufacturer  *NestedObject  `json:"manufacturer"`
+	Model         string         `json:"model"`
+	Slug          string         `json:"slug"`
+	FormFactor    *Choice        `json:"form_factor,omitempty"`
+	Width         *IntChoice     `json:"width,omitempty"`
+	UHeight       int            `json:"u_height"`
+	StartingUnit  int            `json:"starting_unit"`
+	DescUnits     bool           `json:"desc_units"`
+	OuterWidth    *int           `json:"outer_width,omitempty"`
+	OuterDepth    *int           `json:"outer_depth,omitempty"`
+	OuterUnit     *Choice        `json:"outer_unit,omitempty"`
+	Weight        *float64       `json:"weight,omitempty"`
+	MaxWeight     *int           `json:"max_weight,omitempty"`
+	WeightUnit    *Choice        `json:"weight_unit,omitempty"`
+	MountingDepth *int           `json:"mounting_depth,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Comments      string         `json:"comments,omitempty"`
+	Tags          []models.Tag   `json:"tags,omitempty"`
+	CustomFields  map[string]any `json:"custom_fields,omitempty"`
+	Created       string         `json:"created"`
+	LastUpdated   string         `json:"last_updated"`
+	RackCount     int            `json:"rack_count"`
+}
+
+// CreateRackTypeInput represents the input for creating a rack type
+type CreateRackTypeInput struct {
+	Manufacturer  int                `json:"manufacturer"`
+	Model         string             `json:"model"`
+	Slug          string             `json:"slug"`
+	FormFactor    string             `json:"form_factor"`
+	Width         int                `json:"width,omitempty"`
+	UHeight       int                `json:"u_height,omitempty"`
+	StartingUnit  int                `json:"starting_unit,omitempty"`
+	DescUnits     bool               `json:"desc_units,omitempty"`
+	OuterWidth    *int               `json:"outer_width,omitempty"`
+	OuterDepth    *int               `json:"outer_depth,omitempty"`
+	OuterUnit     string             `json:"outer_unit,omitempty"`
+	Weight        *float64           `json:"weight,omitempty"`
+	MaxWeight     *int               `json:"max_weight,omitempty"`
+	WeightUnit    string             `json:"weight_unit,omitempty"`
+	MountingDepth *int               `json:"mounting_depth,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Comments      string             `json:"comments,omitempty"`
+	Tags          []models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRackTypeInput
+func (input *CreateRackTypeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Manufacturer == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "manufacturer",
+			Message: "Manufacturer is required",
+		})
+	}
+
+	if err := models.ValidateRequired("model", input.Model); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateOneOf("form_factor", input.FormFactor, rackFormFactors...); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validateRackDimensions(input.Width, input.UHeight)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRackTypeInput represents the input for updating a rack type
+type UpdateRackTypeInput CreateRackTypeInput
+
+// Validate validates the UpdateRackTypeInput
+func (input *UpdateRackTypeInput) Validate() error {
+	return (*CreateRackTypeInput)(input).Validate()
+}
+
+// PatchRackTypeInput represents the input for patching a rack type
+type PatchRackTypeInput struct {
+	Manufacturer  *int                `json:"manufacturer,omitempty"`
+	Model         *string             `json:"model,omitempty"`
+	Slug          *string             `json:"slug,omitempty"`
+	FormFactor    *string             `json:"form_factor,omitempty"`
+	Width         *int                `json:"width,omitempty"`
+	UHeight       *int                `json:"u_height,omitempty"`
+	StartingUnit  *int                `json:"starting_unit,omitempty"`
+	DescUnits     *bool               `json:"desc_units,omitempty"`
+	OuterWidth    *int                `json:"outer_width,omitempty"`
+	OuterDepth    *int                `json:"outer_depth,omitempty"`
+	OuterUnit     *string             `json:"outer_unit,omitempty"`
+	Weight        *float64            `json:"weight,omitempty"`
+	MaxWeight     *int                `json:"max_weight,omitempty"`
+	WeightUnit    *string             `json:"weight_unit,omitempty"`
+	MountingDepth *int                `json:"mounting_depth,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Comments      *string             `json:"comments,omitempty"`
+	Tags          *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRackTypeInput
+func (input *PatchRackTypeInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.FormFactor != nil {
+		if err := models.ValidateOneOf("form_factor", *input.FormFactor, rackFormFactors...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	var width, uHeight int
+	if input.Width != nil {
+		width = *input.Width
+	}
+	if input.UHeight != nil {
+		uHeight = *input.UHeight
+	}
+	errors = append(errors, validateRackDimensions(width, uHeight)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateRackDimensions checks the width and height shared by racks and rack types. Zero values are skipped.
+func validateRackDimensions(width, uHeight int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if width != 0 && width != RackWidth10 && width != RackWidth19 && width != RackWidth21 && width != RackWidth23 {
+		errors = append(errors, models.ValidationError{
+			Field:   "width",
+			Message: "must be one of: 10, 19, 21, 23",
+		})
+	}
+
+	if uHeight != 0 {
+		if err := models.ValidateRange("u_height", float64(uHeight), 1, 100); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	return errors
+}
+
+// ListRackTypesInput represents the input for listing rack types
+type ListRackTypesInput struct {
+	Query          string   `query:"q"`               // General search
+	Model          string   `query:"model__ic"`       // Filter by model (case-insensitive partial match)
+	Slug           []string `query:"slug"`            // Filter by slug
+	ManufacturerID []int    `query:"manufacturer_id"` // Filter by manufacturer ID
+	FormFactor     []string `query:"form_factor"`     // Filter by form factor
+	Tag            []string `query:"tag"`             // Filter by tag slug
+	Limit          int      `query:"limit"`           // Number of results to return per page
+	Offset         int      `query:"offset"`          // The initial index from which to return the results
+}
@@ -21,11 +21,11 @@ type Region struct {
 
 // ListRegionsInput represents the input for listing regions
 type ListRegionsInput struct {
-	Name   string
-	Parent string
-	Tag    string
-	Limit  int
-	Offset int
+	Name   string `query:"name__ic"`
+	Parent string `query:"parent_id"`
+	Tag    string `query:"tag"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
 }
 
 // CreateRegionInput represents the input for creating a region
@@ -100,18 +100,30 @@ type PatchRegionInput struct {
 
 // Validate validates the PatchRegionInput
 func (input *PatchRegionInput) Validate() error {
+	var errors models.ValidationErrors
+
 	if input.ID == 0 {
-		return &models.ValidationError{
+		errors = append(errors, models.ValidationError{
 			Field:   "id",
 			Message: "ID is required",
+		})
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
 	if input.Slug != nil {
 		if err := models.ValidateSlug(*input.Slug); err != nil {
-			return err
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
+	if len(errors) > 0 {
+		return errors
+	}
+
 	return nil
 }
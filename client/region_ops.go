@@ -1,174 +1,88 @@
 package client
 
 import (
-	"fmt"
-	"net/http"
+	"context"
 )
 
-// ListRegions lists all regions
+// RegionResource is the typed resource for dcim/regions
+type RegionResource = Resource[Region, CreateRegionInput, UpdateRegionInput, PatchRegionInput, ListRegionsInput]
+
+// Regions returns the typed resource for dcim/regions
+func (c *Client) Regions() *RegionResource {
+	return NewResource[Region, CreateRegionInput, UpdateRegionInput, PatchRegionInput, ListRegionsInput](c, "dcim", "regions")
+}
+
+// ListRegions lists all regions matching the input criteria
 func (c *Client) ListRegions(input *ListRegionsInput) ([]Region, error) {
-	path := c.BuildPath("dcim", "regions")
-
-	// Build query parameters
-	params := map[string]string{}
-	if input.Name != "" {
-		params["name__ic"] = input.Name
-	}
-	if input.Parent != "" {
-		params["parent_id"] = input.Parent
-	}
-	if input.Tag != "" {
-		params["tag"] = input.Tag
-	}
-	if input.Limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", input.Limit)
-	}
-	if input.Offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", input.Offset)
-	}
-
-	// Make request
-	var response Response
-	response.Results = make([]any, 0)
-	_, err := c.R().
-		SetQueryParams(params).
-		SetResult(&response).
-		Get(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error listing regions: %w", err)
-	}
-
-	// Convert results to []Region
-	regions := make([]Region, len(response.Results))
-	for i, result := range response.Results {
-		resultMap, ok := result.(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("unexpected result type at index %d", i)
-		}
-
-		// Create a new Region
-		var region Region
-		err := convertMapToStruct(resultMap, &region)
-		if err != nil {
-			return nil, fmt.Errorf("error converting map to struct at index %d: %w", i, err)
-		}
-
-		regions[i] = region
-	}
-
-	return regions, nil
+	return c.ListRegionsWithContext(context.Background(), input)
 }
 
-// GetRegion retrieves a single region by ID
-func (c *Client) GetRegion(id int) (*Region, error) {
-	path := c.BuildPath("dcim", "regions", fmt.Sprintf("%d", id))
+// ListRegionsWithContext lists all regions matching the input criteria using the provided context
+func (c *Client) ListRegionsWithContext(ctx context.Context, input *ListRegionsInput) ([]Region, error) {
+	return c.Regions().List(ctx, input)
+}
+
+// RegionsPager returns a pager over all regions matching the input criteria
+func (c *Client) RegionsPager(input *ListRegionsInput, opts *PagerOptions) *Pager[Region] {
+	return c.Regions().Pager(input, opts)
+}
 
-	var region Region
-	resp, err := c.R().
-		SetResult(&region).
-		Get(path)
+// ListAllRegions lists all regions matching the input criteria, following pagination until exhausted
+func (c *Client) ListAllRegions(input *ListRegionsInput, opts *PagerOptions) ([]Region, error) {
+	return c.ListAllRegionsWithContext(context.Background(), input, opts)
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("error getting region: %w", err)
-	}
+// ListAllRegionsWithContext lists all regions matching the input criteria, following pagination until exhausted, using the provided context
+func (c *Client) ListAllRegionsWithContext(ctx context.Context, input *ListRegionsInput, opts *PagerOptions) ([]Region, error) {
+	return c.Regions().ListAll(ctx, input, opts)
+}
 
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("region not found")
-	}
+// GetRegion retrieves a single region by ID
+func (c *Client) GetRegion(id int) (*Region, error) {
+	return c.GetRegionWithContext(context.Background(), id)
+}
 
-	return &region, nil
+// GetRegionWithContext retrieves a single region by ID using the provided context
+func (c *Client) GetRegionWithContext(ctx context.Context, id int) (*Region, error) {
+	return c.Regions().Get(ctx, id)
 }
 
 // CreateRegion creates a new region
 func (c *Client) CreateRegion(input *CreateRegionInput) (*Region, error) {
-	path := c.BuildPath("dcim", "regions")
-
-	var region Region
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&region).
-		Post(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating region: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.CreateRegionWithContext(context.Background(), input)
+}
 
-	return &region, nil
+// CreateRegionWithContext creates a new region using the provided context
+func (c *Client) CreateRegionWithContext(ctx context.Context, input *CreateRegionInput) (*Region, error) {
+	return c.Regions().Create(ctx, input)
 }
 
 // UpdateRegion updates an existing region
 func (c *Client) UpdateRegion(input *UpdateRegionInput) (*Region, error) {
-	path := c.BuildPath("dcim", "regions", fmt.Sprintf("%d", input.ID))
-
-	var region Region
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&region).
-		Put(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error updating region: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("region not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.UpdateRegionWithContext(context.Background(), input)
+}
 
-	return &region, nil
+// UpdateRegionWithContext updates an existing region using the provided context
+func (c *Client) UpdateRegionWithContext(ctx context.Context, input *UpdateRegionInput) (*Region, error) {
+	return c.Regions().Update(ctx, input.ID, input)
 }
 
 // PatchRegion patches an existing region
 func (c *Client) PatchRegion(input *PatchRegionInput) (*Region, error) {
-	path := c.BuildPath("dcim", "regions", fmt.Sprintf("%d", input.ID))
-
-	var region Region
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&region).
-		Patch(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error patching region: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("region not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.PatchRegionWithContext(context.Background(), input)
+}
 
-	return &region, nil
+// PatchRegionWithContext patches an existing region using the provided context
+func (c *Client) PatchRegionWithContext(ctx context.Context, input *PatchRegionInput) (*Region, error) {
+	return c.Regions().Patch(ctx, input.ID, input)
 }
 
 // DeleteRegion deletes a region
 func (c *Client) DeleteRegion(id int) error {
-	path := c.BuildPath("dcim", "regions", fmt.Sprintf("%d", id))
-
-	resp, err := c.R().
-		Delete(path)
-
-	if err != nil {
-		return fmt.Errorf("error deleting region: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return fmt.Errorf("region not found")
-	}
-
-	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.DeleteRegionWithContext(context.Background(), id)
+}
 
-	return nil
+// DeleteRegionWithContext deletes a region using the provided context
+func (c *Client) DeleteRegionWithContext(ctx context.Context, id int) error {
+	return c.Regions().Delete(ctx, id)
 }
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Resource provides typed List/Get/Create/Update/Patch/Delete operations for a
+// single Netbox endpoint. T is the object type returned by the API, C, U and P
+// are the create, update and patch inputs and L is the list filter, whose
+// `query` struct tags define the query parameters sent by List.
+type Resource[T, C, U, P, L any] struct {
+	client   *Client
+	app      string
+	endpoint string
+}
+
+// NewResource creates a typed resource for the given app and endpoint, e.g. ("dcim", "sites")
+func NewResource[T, C, U, P, L any](c *Client, app, endpoint string) *Resource[T, C, U, P, L] {
+	return &Resource[T, C, U, P, L]{
+		client:   c,
+		app:      app,
+		endpoint: endpoint,
+	}
+}
+
+// Path builds the full API path for the resource, with optional trailing parts
+func (r *Resource[T, C, U, P, L]) Path(parts ...string) string {
+	return r.client.BuildPath(append([]string{r.app, r.endpoint}, parts...)...)
+}
+
+// name returns the resource name used in error messages
+func (r *Resource[T, C, U, P, L]) name() string {
+	return r.app + "/" + r.endpoint
+}
+
+// List returns a single page of objects matching the filter
+func (r *Resource[T, C, U, P, L]) List(ctx context.Context, filter *L) ([]T, error) {
+	var response page[T]
+	resp, err := r.client.RWithContext(ctx).
+		SetQueryParamsFromValues(encodeQuery(filter)).
+		SetResult(&response).
+		Get(r.Path())
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", r.name(), err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	if response.Results == nil {
+		response.Results = make([]T, 0)
+	}
+
+	return response.Results, nil
+}
+
+// Pager returns a pager over all objects matching the filter
+func (r *Resource[T, C, U, P, L]) Pager(filter *L, opts *PagerOptions) *Pager[T] {
+	return newPager[T](r.client, r.Path(), filter, opts)
+}
+
+// ListAll returns all objects matching the filter, following pagination until exhausted
+func (r *Resource[T, C, U, P, L]) ListAll(ctx context.Context, filter *L, opts *PagerOptions) ([]T, error) {
+	return r.Pager(filter, opts).All(ctx)
+}
+
+// Get retrieves a single object by ID
+func (r *Resource[T, C, U, P, L]) Get(ctx context.Context, id int) (*T, error) {
+	var obj T
+	resp, err := r.client.RWithContext(ctx).
+		SetResult(&obj).
+		Get(r.Path(fmt.Sprintf("%d", id)))
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s %d: %w", r.name(), id, err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Create creates a new object. The input is validated first if it implements models.Validator.
+func (r *Resource[T, C, U, P, L]) Create(ctx context.Context, input *C) (*T, error) {
+	if err := r.client.validate(input); err != nil {
+		return nil, err
+	}
+
+	var obj T
+	resp, err := r.client.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&obj).
+		Post(r.Path())
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %w", r.name(), err)
+	}
+
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Update replaces an existing object. The input is validated first if it implements models.Validator.
+func (r *Resource[T, C, U, P, L]) Update(ctx context.Context, id int, input *U) (*T, error) {
+	if err := r.client.validate(input); err != nil {
+		return nil, err
+	}
+
+	var obj T
+	resp, err := r.client.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&obj).
+		Put(r.Path(fmt.Sprintf("%d", id)))
+
+	if err != nil {
+		return nil, fmt.Errorf("error updating %s %d: %w", r.name(), id, err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Patch partially updates an existing object. The input is validated first if it implements models.Validator.
+func (r *Resource[T, C, U, P, L]) Patch(ctx context.Context, id int, input *P) (*T, error) {
+	if err := r.client.validate(input); err != nil {
+		return nil, err
+	}
+
+	var obj T
+	resp, err := r.client.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&obj).
+		Patch(r.Path(fmt.Sprintf("%d", id)))
+
+	if err != nil {
+		return nil, fmt.Errorf("error patching %s %d: %w", r.name(), id, err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Delete deletes an object by ID
+func (r *Resource[T, C, U, P, L]) Delete(ctx context.Context, id int) error {
+	resp, err := r.client.RWithContext(ctx).
+		Delete(r.Path(fmt.Sprintf("%d", id)))
+
+	if err != nil {
+		return fmt.Errorf("error deleting %s %d: %w", r.name(), id, err)
+	}
+
+	if err := checkResponse(resp, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
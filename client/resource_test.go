@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource(t *testing.T) {
+	type widget struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	type widgetInput struct {
+		Name string `json:"name"`
+	}
+	type listWidgetsInput struct {
+		Name string `query:"name__ic"`
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		query      string
+		status     int
+		response   string
+		wantBody   string
+		call       func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error)
+		wantResult any
+	}{
+		{
+			name:     "list",
+			method:   http.MethodGet,
+			path:     "/api/test/widgets/",
+			query:    "name__ic=foo",
+			status:   http.StatusOK,
+			response: `{"count": 1, "next": null, "previous": null, "results": [{"id": 1, "name": "foo"}]}`,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return r.List(context.Background(), &listWidgetsInput{Name: "foo"})
+			},
+			wantResult: []widget{{ID: 1, Name: "foo"}},
+		},
+		{
+			name:     "get",
+			method:   http.MethodGet,
+			path:     "/api/test/widgets/1/",
+			status:   http.StatusOK,
+			response: `{"id": 1, "name": "foo"}`,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return r.Get(context.Background(), 1)
+			},
+			wantResult: &widget{ID: 1, Name: "foo"},
+		},
+		{
+			name:     "create",
+			method:   http.MethodPost,
+			path:     "/api/test/widgets/",
+			status:   http.StatusCreated,
+			response: `{"id": 2, "name": "bar"}`,
+			wantBody: `{"name": "bar"}`,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return r.Create(context.Background(), &widgetInput{Name: "bar"})
+			},
+			wantResult: &widget{ID: 2, Name: "bar"},
+		},
+		{
+			name:     "update",
+			method:   http.MethodPut,
+			path:     "/api/test/widgets/2/",
+			status:   http.StatusOK,
+			response: `{"id": 2, "name": "baz"}`,
+			wantBody: `{"name": "baz"}`,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return r.Update(context.Background(), 2, &widgetInput{Name: "baz"})
+			},
+			wantResult: &widget{ID: 2, Name: "baz"},
+		},
+		{
+			name:     "patch",
+			method:   http.MethodPatch,
+			path:     "/api/test/widgets/2/",
+			status:   http.StatusOK,
+			response: `{"id": 2, "name": "qux"}`,
+			wantBody: `{"name": "qux"}`,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return r.Patch(context.Background(), 2, &widgetInput{Name: "qux"})
+			},
+			wantResult: &widget{ID: 2, Name: "qux"},
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			path:   "/api/test/widgets/2/",
+			status: http.StatusNoContent,
+			call: func(r *Resource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput]) (any, error) {
+				return nil, r.Delete(context.Background(), 2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.method, r.Method)
+				assert.Equal(t, tt.path, r.URL.Path)
+				assert.Equal(t, tt.query, r.URL.RawQuery)
+				if tt.wantBody != "" {
+					var body map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					var want map[string]any
+					require.NoError(t, json.Unmarshal([]byte(tt.wantBody), &want))
+					assert.Equal(t, want, body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				if tt.response != "" {
+					_, _ = w.Write([]byte(tt.response))
+				}
+			})
+
+			client := newTestClient(t, ts)
+
+			r := NewResource[widget, widgetInput, widgetInput, widgetInput, listWidgetsInput](client, "test", "widgets")
+			result, err := tt.call(r)
+			require.NoError(t, err)
+			if tt.wantResult != nil {
+				assert.Equal(t, tt.wantResult, result)
+			}
+		})
+	}
+}
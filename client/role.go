@@ -0,0 +1,118 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Role represents a Netbox IPAM role, the function a prefix or VLAN serves
+// such as "production" or "management". Roles are ordered by weight, lowest
+// first.
+type Role struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Slug         string         `json:"slug"`
+	Weight       int            `json:"weight"`
+	Description  string         `json:"description,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	PrefixCount  int            `json:"prefix_count"`
+	VLANCount    int            `json:"vlan_count"`
+}
+
+// CreateRoleInput represents the input for creating an IPAM role. Netbox
+// defaults the weight to 1000.
+type CreateRoleInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	Weight       *int               `json:"weight,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRoleInput
+func (input *CreateRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Weight != nil {
+		if err := models.ValidateRange("weight", float64(*input.Weight), 0, 32767); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRoleInput represents the input for updating an IPAM role
+type UpdateRoleInput CreateRoleInput
+
+// Validate validates the UpdateRoleInput
+func (input *UpdateRoleInput) Validate() error {
+	return (*CreateRoleInput)(input).Validate()
+}
+
+// PatchRoleInput represents the input for patching an IPAM role
+type PatchRoleInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	Weight       *int                `json:"weight,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRoleInput
+func (input *PatchRoleInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Weight != nil {
+		if err := models.ValidateRange("weight", float64(*input.Weight), 0, 32767); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRolesInput represents the input for listing IPAM roles
+type ListRolesInput struct {
+	Query  string   `query:"q"`        // General search
+	Name   string   `query:"name__ic"` // Filter by name (case-insensitive partial match)
+	Slug   []string `query:"slug"`     // Filter by slug
+	Weight []int    `query:"weight"`   // Filter by weight
+	Tag    []string `query:"tag"`      // Filter by tag slug
+	Limit  int      `query:"limit"`    // Number of results to return per page
+	Offset int      `query:"offset"`   // The initial index from which to return the results
+}
@@ -0,0 +1,9 @@
+package client
+
+// RoleResource is the typed resource for ipam/roles
+type RoleResource = Resource[Role, CreateRoleInput, UpdateRoleInput, PatchRoleInput, ListRolesInput]
+
+// Roles returns the typed resource for ipam/roles, the roles of prefixes and VLANs
+func (c *Client) Roles() *RoleResource {
+	return NewResource[Role, CreateRoleInput, UpdateRoleInput, PatchRoleInput, ListRolesInput](c, "ipam", "roles")
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid protocol values for services and service templates
+const (
+	ServiceProtocolTCP  = "tcp"
+	ServiceProtocolUDP  = "udp"
+	ServiceProtocolSCTP = "sctp"
+)
+
+// serviceProtocols lists all valid service protocol values
+var serviceProtocols = []string{
+	ServiceProtocolTCP,
+	ServiceProtocolUDP,
+	ServiceProtocolSCTP,
+}
+
+// Service represents a Netbox service, a layer four application listening on
+// a device or virtual machine. IPAddresses limits the service to specific
+// addresses; when empty it listens on all of them.
+type Service struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Device         *Device        `json:"device,omitempty"`
+	VirtualMachine *NestedObject  `json:"virtual_machine,omitempty"`
+	Name           string         `json:"name"`
+	Protocol       *Choice        `json:"protocol"`
+	Ports          []int          `json:"ports"`
+	IPAddresses    []IPAddress    `json:"ipaddresses,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+}
+
+// CreateServiceInput represents the input for creating a service. Exactly one
+// of Device and VirtualMachine must be set.
+type CreateServiceInput struct {
+	Device         int                `json:"device,omitempty"`
+	VirtualMachine int                `json:"virtual_machine,omitempty"`
+	Name           string             `json:"name"`
+	Protocol       string             `json:"protocol"`
+	Ports          []int              `json:"ports"`
+	IPAddresses    []int              `json:"ipaddresses,omitempty"`
+	Description    string             `json:"description,omitempty"`
+	Comments       string             `json:"comments,omitempty"`
+	Tags           []models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateServiceInput
+func (input *CreateServiceInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if (input.Device == 0) == (input.VirtualMachine == 0) {
+		errors = append(errors, models.ValidationError{
+			Field:   "device",
+			Message: "exactly one of device and virtual_machine must be set",
+		})
+	}
+
+	errors = append(errors, validateServiceFields(input.Name, input.Protocol, input.Ports)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateServiceInput represents the input for updating a service
+type UpdateServiceInput CreateServiceInput
+
+// Validate validates the UpdateServiceInput
+func (input *UpdateServiceInput) Validate() error {
+	return (*CreateServiceInput)(input).Validate()
+}
+
+// PatchServiceInput represents the input for patching a service
+type PatchServiceInput struct {
+	Device         *int                `json:"device,omitempty"`
+	VirtualMachine *int                `json:"virtual_machine,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	Protocol       *string             `json:"protocol,omitempty"`
+	Ports          *[]int              `json:"ports,omitempty"`
+	IPAddresses    *[]int              `json:"ipaddresses,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Comments       *string             `json:"comments,omitempty"`
+	Tags           *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields   map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchServiceInput
+func (input *PatchServiceInput) Validate() error {
+	errors := validatePatchServiceFields(input.Name, input.Protocol, input.Ports)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListServicesInput represents the input for listing services. Slice fields
+// match any of the given values.
+type ListServicesInput struct {
+	Query            string   `query:"q"`                  // General search
+	Name             []string `query:"name"`               // Filter by name (exact match)
+	Protocol         []string `query:"protocol"`           // Filter by protocol
+	Port             []int    `query:"port"`               // Filter by port
+	DeviceID         []int    `query:"device_id"`          // Filter by device ID
+	Device           []string `query:"device"`             // Filter by device name
+	VirtualMachineID []int    `query:"virtual_machine_id"` // Filter by virtual machine ID
+	VirtualMachine   []string `query:"virtual_machine"`    // Filter by virtual machine name
+	IPAddressID      []int    `query:"ipaddress_id"`       // Filter by IP address ID
+	IPAddress        []string `query:"ipaddress"`          // Filter by IP address
+	Tag              []string `query:"tag"`                // Filter by tag slug
+	Limit            int      `query:"limit"`              // Number of results to return per page
+	Offset           int      `query:"offset"`             // The initial index from which to return the results
+}
+
+// ServiceTemplate represents a Netbox service template, a reusable
+// definition of a service such as "SSH" on tcp/22
+type ServiceTemplate struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Protocol     *Choice        `json:"protocol"`
+	Ports        []int          `json:"ports"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateServiceTemplateInput represents the input for creating a service template
+type CreateServiceTemplateInput struct {
+	Name         string             `json:"name"`
+	Protocol     string             `json:"protocol"`
+	Ports        []int              `json:"ports"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateServiceTemplateInput
+func (input *CreateServiceTemplateInput) Validate() error {
+	errors := validateServiceFields(input.Name, input.Protocol, input.Ports)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateServiceTemplateInput represents the input for updating a service template
+type UpdateServiceTemplateInput CreateServiceTemplateInput
+
+// Validate validates the UpdateServiceTemplateInput
+func (input *UpdateServiceTemplateInput) Validate() error {
+	return (*CreateServiceTemplateInput)(input).Validate()
+}
+
+// PatchServiceTemplateInput represents the input for patching a service template
+type PatchServiceTemplateInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Protocol     *string             `json:"protocol,omitempty"`
+	Ports        *[]int              `json:"ports,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchServiceTemplateInput
+func (input *PatchServiceTemplateInput) Validate() error {
+	errors := validatePatchServiceFields(input.Name, input.Protocol, input.Ports)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListServiceTemplatesInput represents the input for listing service templates
+type ListServiceTemplatesInput struct {
+	Query    string   `query:"q"`        // General search
+	Name     []string `query:"name"`     // Filter by name (exact match)
+	Protocol []string `query:"protocol"` // Filter by protocol
+	Port     []int    `query:"port"`     // Filter by port
+	Tag      []string `query:"tag"`      // Filter by tag slug
+	Limit    int      `query:"limit"`    // Number of results to return per page
+	Offset   int      `query:"offset"`   // The initial index from which to return the results
+}
+
+// CreateServiceFromTemplateInput represents the input for instantiating a
+// service template. Exactly one of Device and VirtualMachine must be set; the
+// name, protocol, ports, description and tags are taken from the template.
+type CreateServiceFromTemplateInput struct {
+	Device         int
+	VirtualMachine int
+	IPAddresses    []int
+}
+
+// Validate validates the CreateServiceFromTemplateInput
+func (input *CreateServiceFromTemplateInput) Validate() error {
+	if (input.Device == 0) == (input.VirtualMachine == 0) {
+		return models.ValidationErrors{{
+			Field:   "device",
+			Message: "exactly one of device and virtual_machine must be set",
+		}}
+	}
+
+	return nil
+}
+
+// validateServiceFields validates the fields shared by services and service templates
+func validateServiceFields(name, protocol string, ports []int) models.ValidationErrors {
+	return validatePatchServiceFields(&name, &protocol, &ports)
+}
+
+// validatePatchServiceFields validates the fields shared by services and
+// service templates, skipping those which are not set
+func validatePatchServiceFields(name, protocol *string, ports *[]int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if name != nil {
+		if err := models.ValidateRequired("name", *name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if protocol != nil {
+		if err := models.ValidateOneOf("protocol", *protocol, serviceProtocols...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if ports != nil {
+		if len(*ports) == 0 {
+			errors = append(errors, models.ValidationError{
+				Field:   "ports",
+				Message: "at least one port is required",
+			})
+		}
+
+		for i, port := range *ports {
+			if err := models.ValidateRange(fmt.Sprintf("ports[%d]", i), float64(port), 1, 65535); err != nil {
+				errors = append(errors, *err.(*models.ValidationError))
+			}
+		}
+	}
+
+	return errors
+}
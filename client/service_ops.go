@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// ServiceResource is the typed resource for ipam/services
+type ServiceResource = Resource[Service, CreateServiceInput, UpdateServiceInput, PatchServiceInput, ListServicesInput]
+
+// Services returns the typed resource for ipam/services
+func (c *Client) Services() *ServiceResource {
+	return NewResource[Service, CreateServiceInput, UpdateServiceInput, PatchServiceInput, ListServicesInput](c, "ipam", "services")
+}
+
+// ServiceTemplateResource is the typed resource for ipam/service-templates
+type ServiceTemplateResource = Resource[ServiceTemplate, CreateServiceTemplateInput, UpdateServiceTemplateInput, PatchServiceTemplateInput, ListServiceTemplatesInput]
+
+// ServiceTemplates returns the typed resource for ipam/service-templates
+func (c *Client) ServiceTemplates() *ServiceTemplateResource {
+	return NewResource[ServiceTemplate, CreateServiceTemplateInput, UpdateServiceTemplateInput, PatchServiceTemplateInput, ListServiceTemplatesInput](c, "ipam", "service-templates")
+}
+
+// CreateServiceFromTemplate creates a service on a device or virtual machine
+// from a service template, copying its name, protocol, ports, description and tags
+func (c *Client) CreateServiceFromTemplate(templateID int, input *CreateServiceFromTemplateInput) (*Service, error) {
+	return c.CreateServiceFromTemplateWithContext(context.Background(), templateID, input)
+}
+
+// CreateServiceFromTemplateWithContext creates a service from a service template using the provided context
+func (c *Client) CreateServiceFromTemplateWithContext(ctx context.Context, templateID int, input *CreateServiceFromTemplateInput) (*Service, error) {
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	template, err := c.ServiceTemplates().Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceInput := &CreateServiceInput{
+		Device:         input.Device,
+		VirtualMachine: input.VirtualMachine,
+		Name:           template.Name,
+		Ports:          template.Ports,
+		IPAddresses:    input.IPAddresses,
+		Description:    template.Description,
+	}
+	if template.Protocol != nil {
+		serviceInput.Protocol = template.Protocol.Value
+	}
+	for _, tag := range template.Tags {
+		serviceInput.Tags = append(serviceInput.Tags, models.TagCreate{Name: tag.Name, Slug: tag.Slug, Color: tag.Color})
+	}
+
+	return c.Services().Create(ctx, serviceInput)
+}
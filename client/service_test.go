@@ -0,0 +1,99 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestCreateServiceFromTemplate(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/service-templates/4/":
+			_, _ = w.Write([]byte(`{"id": 4, "name": "HTTPS", "protocol": {"value": "tcp", "label": "TCP"}, "ports": [443, 8443],
+				"description": "Web UI", "tags": [{"id": 1, "name": "Public", "slug": "public", "color": "00ff00"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/services/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, float64(12), body["device"])
+			assert.NotContains(t, body, "virtual_machine")
+			assert.Equal(t, "HTTPS", body["name"])
+			assert.Equal(t, "tcp", body["protocol"])
+			assert.Equal(t, []any{float64(443), float64(8443)}, body["ports"])
+			assert.Equal(t, []any{float64(30)}, body["ipaddresses"])
+			assert.Equal(t, []any{map[string]any{"name": "Public", "slug": "public", "color": "00ff00"}}, body["tags"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 8, "device": {"id": 12, "name": "fw1"}, "name": "HTTPS",
+				"protocol": {"value": "tcp", "label": "TCP"}, "ports": [443, 8443], "ipaddresses": [{"id": 30, "address": "192.0.2.10/24"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	service, err := client.CreateServiceFromTemplate(4, &CreateServiceFromTemplateInput{Device: 12, IPAddresses: []int{30}})
+	require.NoError(t, err)
+	assert.Equal(t, "fw1", service.Device.Name)
+	assert.Equal(t, "tcp", service.Protocol.Value)
+	assert.Equal(t, []int{443, 8443}, service.Ports)
+	assert.Equal(t, "192.0.2.10/24", service.IPAddresses[0].Address)
+}
+
+func TestServiceValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      models.Validator
+		wantFields []string
+	}{
+		{
+			name:  "valid",
+			input: &CreateServiceInput{Device: 1, Name: "SSH", Protocol: ServiceProtocolTCP, Ports: []int{22}},
+		},
+		{
+			name:       "parent, protocol and ports",
+			input:      &CreateServiceInput{Device: 1, VirtualMachine: 2, Name: "SSH", Protocol: "icmp", Ports: []int{22, 70000}},
+			wantFields: []string{"device", "protocol", "ports[1]"},
+		},
+		{
+			name:       "template without ports",
+			input:      &CreateServiceTemplateInput{Name: "SSH", Protocol: ServiceProtocolTCP},
+			wantFields: []string{"ports"},
+		},
+		{
+			name:  "patch skips unset fields",
+			input: &PatchServiceInput{Description: strPtr("Management")},
+		},
+		{
+			name:       "instantiation without parent",
+			input:      &CreateServiceFromTemplateInput{},
+			wantFields: []string{"device"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErrors models.ValidationErrors
+			require.True(t, errors.As(err, &validationErrors))
+
+			var fields []string
+			for _, e := range validationErrors {
+				fields = append(fields, e.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
@@ -9,3 +9,25 @@ type Status struct {
 	Color       string `json:"color"`
 	Description string `json:"description"`
 }
+
+// Choice represents a choice field value in Netbox, such as an interface type or rack face
+type Choice struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// NestedObject represents a brief reference to another Netbox object
+type NestedObject struct {
+	ID          int    `json:"id"`
+	URL         string `json:"url"`
+	Display     string `json:"display"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// IntChoice represents a choice field with an integer value in Netbox, such as a rack width
+type IntChoice struct {
+	Value int    `json:"value"`
+	Label string `json:"label"`
+}
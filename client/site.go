@@ -1,9 +1,6 @@
 package client
 
 import (
-	"regexp"
-
-	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/zeddD1abl0/go-netbox-client/models"
 )
 
@@ -16,80 +13,104 @@ const (
 	SiteStatusRetired         = "retired"
 )
 
+// siteStatuses lists all valid site status values
+var siteStatuses = []string{
+	SiteStatusActive,
+	SiteStatusPlanned,
+	SiteStatusStaging,
+	SiteStatusDecommissioning,
+	SiteStatusRetired,
+}
+
 // Site represents a Netbox site
 type Site struct {
-	ID              int                `json:"id"`
-	URL             string             `json:"url"`
-	Name            string             `json:"name"`
-	Slug            string             `json:"slug"`
-	Status          *Status            `json:"status"`
-	Region          *Region            `json:"region"`
-	Description     string             `json:"description"`
-	PhysicalAddress string             `json:"physical_address,omitempty"`
-	ShippingAddress string             `json:"shipping_address,omitempty"`
-	Latitude        *float64           `json:"latitude,omitempty"`
-	Longitude       *float64           `json:"longitude,omitempty"`
-	Comments        string             `json:"comments,omitempty"`
-	Tags            []models.TagCreate `json:"tags,omitempty"`
-	CustomFields    map[string]any     `json:"custom_fields,omitempty"`
-	Created         string             `json:"created"`
-	LastUpdated     string             `json:"last_updated"`
+	ID                  int            `json:"id"`
+	URL                 string         `json:"url"`
+	Display             string         `json:"display"`
+	Name                string         `json:"name"`
+	Slug                string         `json:"slug"`
+	Status              *Status        `json:"status"`
+	Region              *Region        `json:"region"`
+	Group               *SiteGroup     `json:"group,omitempty"`
+	Tenant              *NestedObject  `json:"tenant,omitempty"`
+	Facility            string         `json:"facility,omitempty"`
+	TimeZone            string         `json:"time_zone,omitempty"`
+	Description         string         `json:"description"`
+	PhysicalAddress     string         `json:"physical_address,omitempty"`
+	ShippingAddress     string         `json:"shipping_address,omitempty"`
+	Latitude            *float64       `json:"latitude,omitempty"`
+	Longitude           *float64       `json:"longitude,omitempty"`
+	Comments            string         `json:"comments,omitempty"`
+	ASNs                []NestedObject `json:"asns,omitempty"`
+	Tags                []models.Tag   `json:"tags,omitempty"`
+	CustomFields        map[string]any `json:"custom_fields,omitempty"`
+	Created             string         `json:"created"`
+	LastUpdated         string         `json:"last_updated"`
+	CircuitCount        int            `json:"circuit_count"`
+	DeviceCount         int            `json:"device_count"`
+	PrefixCount         int            `json:"prefix_count"`
+	RackCount           int            `json:"rack_count"`
+	VirtualMachineCount int            `json:"virtualmachine_count"`
+	VLANCount           int            `json:"vlan_count"`
 }
 
-// CreateSiteInput represents the input for creating a site
+// CreateSiteInput represents the input for creating a site. ASNs holds the
+// IDs of the ASNs assigned to the site, e.g. one allocated with AllocateASN.
 type CreateSiteInput struct {
 	Name            string             `json:"name"`
 	Slug            string             `json:"slug"`
 	Status          string             `json:"status,omitempty"`
 	Region          int                `json:"region,omitempty"`
+	Group           int                `json:"group,omitempty"`
+	Tenant          int                `json:"tenant,omitempty"`
+	Facility        string             `json:"facility,omitempty"`
+	TimeZone        string             `json:"time_zone,omitempty"`
 	Description     string             `json:"description,omitempty"`
 	PhysicalAddress string             `json:"physical_address,omitempty"`
 	ShippingAddress string             `json:"shipping_address,omitempty"`
 	Latitude        *float64           `json:"latitude,omitempty"`
 	Longitude       *float64           `json:"longitude,omitempty"`
 	Comments        string             `json:"comments,omitempty"`
+	ASNs            []int              `json:"asns,omitempty"`
 	Tags            []models.TagCreate `json:"tags,omitempty"`
 	CustomFields    map[string]any     `json:"custom_fields,omitempty"`
 }
 
 // Validate validates the CreateSiteInput
 func (input *CreateSiteInput) Validate() error {
-	return validation.ValidateStruct(input,
-		validation.Field(&input.Name, validation.Required),
-		validation.Field(&input.Slug, validation.Required),
-		validation.Field(&input.Status, validation.By(func(value any) error {
-			validStatuses := []string{
-				SiteStatusActive,
-				SiteStatusPlanned,
-				SiteStatusStaging,
-				SiteStatusDecommissioning,
-				SiteStatusRetired,
-			}
-			isValid := false
-			for _, status := range validStatuses {
-				if input.Status == status {
-					isValid = true
-					break
-				}
-			}
-			if !isValid {
-				return validation.NewError("status", "must be one of: active, planned, staging, decommissioning, retired")
-			}
-			return nil
-		})),
-		validation.Field(&input.Latitude, validation.By(func(value any) error {
-			if input.Latitude != nil && (*input.Latitude < -90 || *input.Latitude > 90) {
-				return validation.NewError("latitude", "must be between -90 and 90")
-			}
-			return nil
-		})),
-		validation.Field(&input.Longitude, validation.By(func(value any) error {
-			if input.Longitude != nil && (*input.Longitude < -180 || *input.Longitude > 180) {
-				return validation.NewError("longitude", "must be between -180 and 180")
-			}
-			return nil
-		})),
-	)
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, siteStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Latitude != nil {
+		if err := models.ValidateRange("latitude", *input.Latitude, -90, 90); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Longitude != nil {
+		if err := models.ValidateRange("longitude", *input.Longitude, -180, 180); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
 }
 
 // UpdateSiteInput represents the input for updating a site
@@ -99,116 +120,123 @@ type UpdateSiteInput struct {
 	Slug            string             `json:"slug"`
 	Status          string             `json:"status,omitempty"`
 	Region          int                `json:"region,omitempty"`
+	Group           int                `json:"group,omitempty"`
+	Tenant          int                `json:"tenant,omitempty"`
+	Facility        string             `json:"facility,omitempty"`
+	TimeZone        string             `json:"time_zone,omitempty"`
 	Description     string             `json:"description,omitempty"`
 	PhysicalAddress string             `json:"physical_address,omitempty"`
 	ShippingAddress string             `json:"shipping_address,omitempty"`
 	Latitude        *float64           `json:"latitude,omitempty"`
 	Longitude       *float64           `json:"longitude,omitempty"`
 	Comments        string             `json:"comments,omitempty"`
+	ASNs            []int              `json:"asns,omitempty"`
 	Tags            []models.TagCreate `json:"tags,omitempty"`
 	CustomFields    map[string]any     `json:"custom_fields,omitempty"`
 }
 
 // Validate validates the UpdateSiteInput
 func (input *UpdateSiteInput) Validate() error {
-	return validation.ValidateStruct(input,
-		validation.Field(&input.Name, validation.Required),
-		validation.Field(&input.Slug, validation.Required),
-		validation.Field(&input.Status, validation.By(func(value any) error {
-			validStatuses := []string{
-				SiteStatusActive,
-				SiteStatusPlanned,
-				SiteStatusStaging,
-				SiteStatusDecommissioning,
-				SiteStatusRetired,
-			}
-			isValid := false
-			for _, status := range validStatuses {
-				if input.Status == status {
-					isValid = true
-					break
-				}
-			}
-			if !isValid {
-				return validation.NewError("status", "must be one of: active, planned, staging, decommissioning, retired")
-			}
-			return nil
-		})),
-		validation.Field(&input.Latitude, validation.By(func(value any) error {
-			if input.Latitude != nil && (*input.Latitude < -90 || *input.Latitude > 90) {
-				return validation.NewError("latitude", "must be between -90 and 90")
-			}
-			return nil
-		})),
-		validation.Field(&input.Longitude, validation.By(func(value any) error {
-			if input.Longitude != nil && (*input.Longitude < -180 || *input.Longitude > 180) {
-				return validation.NewError("longitude", "must be between -180 and 180")
-			}
-			return nil
-		})),
-	)
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, siteStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Latitude != nil {
+		if err := models.ValidateRange("latitude", *input.Latitude, -90, 90); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Longitude != nil {
+		if err := models.ValidateRange("longitude", *input.Longitude, -180, 180); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
 }
 
 // PatchSiteInput represents the input for patching a site
 type PatchSiteInput struct {
-	ID              *int                   `json:"-"`
-	Name            *string                `json:"name,omitempty"`
-	Slug            *string                `json:"slug,omitempty"`
-	Status          *string                `json:"status,omitempty"`
-	Region          *int                   `json:"region,omitempty"`
-	Group           *int                   `json:"group,omitempty"`
-	Tenant          *int                   `json:"tenant,omitempty"`
-	Facility        *string                `json:"facility,omitempty"`
-	TimeZone        *string                `json:"time_zone,omitempty"`
-	Description     *string                `json:"description,omitempty"`
-	PhysicalAddress *string                `json:"physical_address,omitempty"`
-	ShippingAddress *string                `json:"shipping_address,omitempty"`
-	Latitude        *float64               `json:"latitude,omitempty"`
-	Longitude       *float64               `json:"longitude,omitempty"`
-	Comments        *string                `json:"comments,omitempty"`
-	AsnsIDs         *[]int                 `json:"asns,omitempty"`
-	Tags            *[]int                 `json:"tags,omitempty"`
-	CustomFields    map[string]interface{} `json:"custom_fields,omitempty"`
+	ID              *int                `json:"-"`
+	Name            *string             `json:"name,omitempty"`
+	Slug            *string             `json:"slug,omitempty"`
+	Status          *string             `json:"status,omitempty"`
+	Region          *int                `json:"region,omitempty"`
+	Group           *int                `json:"group,omitempty"`
+	Tenant          *int                `json:"tenant,omitempty"`
+	Facility        *string             `json:"facility,omitempty"`
+	TimeZone        *string             `json:"time_zone,omitempty"`
+	Description     *string             `json:"description,omitempty"`
+	PhysicalAddress *string             `json:"physical_address,omitempty"`
+	ShippingAddress *string             `json:"shipping_address,omitempty"`
+	Latitude        *float64            `json:"latitude,omitempty"`
+	Longitude       *float64            `json:"longitude,omitempty"`
+	Comments        *string             `json:"comments,omitempty"`
+	AsnsIDs         *[]int              `json:"asns,omitempty"`
+	Tags            *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields    map[string]any      `json:"custom_fields,omitempty"`
 }
 
 // Validate validates the PatchSiteInput
 func (input *PatchSiteInput) Validate() error {
+	var errors models.ValidationErrors
+
 	if input.ID == nil {
-		return validation.NewError("id", "is required")
+		errors = append(errors, models.ValidationError{
+			Field:   "id",
+			Message: "ID is required",
+		})
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
 	}
 
 	if input.Slug != nil {
-		if err := validation.Validate(*input.Slug, validation.Match(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`))); err != nil {
-			return validation.NewError("slug", "must be a valid slug")
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
 	if input.Status != nil {
-		validStatuses := []string{
-			SiteStatusActive,
-			SiteStatusPlanned,
-			SiteStatusStaging,
-			SiteStatusDecommissioning,
-			SiteStatusRetired,
-		}
-		isValid := false
-		for _, status := range validStatuses {
-			if *input.Status == status {
-				isValid = true
-				break
-			}
+		if err := models.ValidateOneOf("status", *input.Status, siteStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
-		if !isValid {
-			return validation.NewError("status", "must be one of: active, planned, staging, decommissioning, retired")
+	}
+
+	if input.Latitude != nil {
+		if err := models.ValidateRange("latitude", *input.Latitude, -90, 90); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
-	if input.Latitude != nil && (*input.Latitude < -90 || *input.Latitude > 90) {
-		return validation.NewError("latitude", "must be between -90 and 90")
+	if input.Longitude != nil {
+		if err := models.ValidateRange("longitude", *input.Longitude, -180, 180); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
 	}
 
-	if input.Longitude != nil && (*input.Longitude < -180 || *input.Longitude > 180) {
-		return validation.NewError("longitude", "must be between -180 and 180")
+	if len(errors) > 0 {
+		return errors
 	}
 
 	return nil
@@ -216,25 +244,55 @@ func (input *PatchSiteInput) Validate() error {
 
 // ListSitesInput represents the input for listing sites
 type ListSitesInput struct {
-	Name   string `json:"name,omitempty"`   // Filter by name (case-insensitive partial match)
-	Region string `json:"region,omitempty"` // Filter by region ID
-	Status string `json:"status,omitempty"` // Filter by status
-	Tag    string `json:"tag,omitempty"`    // Filter by tag
-	Limit  int    `json:"limit,omitempty"`  // Number of results to return per page
-	Offset int    `json:"offset,omitempty"` // The initial index from which to return the results
+	Query         string   `json:"q,omitempty" query:"q"`                                 // General search
+	Name          string   `json:"name,omitempty" query:"name__ic"`                       // Filter by name (case-insensitive partial match)
+	Slug          []string `json:"slug,omitempty" query:"slug"`                           // Filter by slug
+	Region        string   `json:"region,omitempty" query:"region"`                       // Filter by region ID
+	GroupID       []int    `json:"group_id,omitempty" query:"group_id"`                   // Filter by site group ID
+	Group         []string `json:"group,omitempty" query:"group"`                         // Filter by site group slug
+	TenantID      []int    `json:"tenant_id,omitempty" query:"tenant_id"`                 // Filter by tenant ID
+	Tenant        []string `json:"tenant,omitempty" query:"tenant"`                       // Filter by tenant slug
+	Facility      []string `json:"facility,omitempty" query:"facility"`                   // Filter by facility
+	ASN           []int    `json:"asn,omitempty" query:"asn"`                             // Filter by AS number
+	ASNID         []int    `json:"asn_id,omitempty" query:"asn_id"`                       // Filter by ASN ID
+	TimeZone      []string `json:"time_zone,omitempty" query:"time_zone"`                 // Filter by time zone, e.g. "Europe/London"
+	Status        string   `json:"status,omitempty" query:"status"`                       // Filter by status
+	CreatedAfter  string   `json:"created__gte,omitempty" query:"created__gte"`           // Filter by creation time (ISO 8601, inclusive)
+	CreatedBefore string   `json:"created__lte,omitempty" query:"created__lte"`           // Filter by creation time (ISO 8601, inclusive)
+	UpdatedAfter  string   `json:"last_updated__gte,omitempty" query:"last_updated__gte"` // Filter by last update time (ISO 8601, inclusive)
+	UpdatedBefore string   `json:"last_updated__lte,omitempty" query:"last_updated__lte"` // Filter by last update time (ISO 8601, inclusive)
+	Tag           string   `json:"tag,omitempty" query:"tag"`                             // Filter by tag
+	Limit         int      `json:"limit,omitempty" query:"limit"`                         // Number of results to return per page
+	Offset        int      `json:"offset,omitempty" query:"offset"`                       // The initial index from which to return the results
 }
 
 // Validate validates the ListSitesInput
 func (input *ListSitesInput) Validate() error {
-	return validation.ValidateStruct(input,
-		validation.Field(&input.Status, validation.In(
-			SiteStatusActive,
-			SiteStatusPlanned,
-			SiteStatusStaging,
-			SiteStatusDecommissioning,
-			SiteStatusRetired,
-		)),
-		validation.Field(&input.Limit, validation.Min(0)),
-		validation.Field(&input.Offset, validation.Min(0)),
-	)
+	var errors models.ValidationErrors
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, siteStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Limit < 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "limit",
+			Message: "must be no less than 0",
+		})
+	}
+
+	if input.Offset < 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "offset",
+			Message: "must be no less than 0",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
 }
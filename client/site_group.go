@@ -20,11 +20,11 @@ type SiteGroup struct {
 
 // ListSiteGroupsInput represents the input for listing site groups
 type ListSiteGroupsInput struct {
-	Name   string
-	Parent string
-	Tag    string
-	Limit  int
-	Offset int
+	Name   string `query:"name__ic"`
+	Parent string `query:"parent"`
+	Tag    string `query:"tag"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
 }
 
 // CreateSiteGroupInput represents the input for creating a site group
@@ -99,18 +99,30 @@ type PatchSiteGroupInput struct {
 
 // Validate validates the PatchSiteGroupInput
 func (input *PatchSiteGroupInput) Validate() error {
+	var errors models.ValidationErrors
+
 	if input.ID == 0 {
-		return &models.ValidationError{
+		errors = append(errors, models.ValidationError{
 			Field:   "id",
 			Message: "ID is required",
+		})
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
 	if input.Slug != nil {
 		if err := models.ValidateSlug(*input.Slug); err != nil {
-			return err
+			errors = append(errors, *err.(*models.ValidationError))
 		}
 	}
 
+	if len(errors) > 0 {
+		return errors
+	}
+
 	return nil
 }
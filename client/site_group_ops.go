@@ -1,174 +1,88 @@
 package client
 
 import (
-	"fmt"
-	"net/http"
+	"context"
 )
 
-// ListSiteGroups lists all site groups
+// SiteGroupResource is the typed resource for dcim/site-groups
+type SiteGroupResource = Resource[SiteGroup, CreateSiteGroupInput, UpdateSiteGroupInput, PatchSiteGroupInput, ListSiteGroupsInput]
+
+// SiteGroups returns the typed resource for dcim/site-groups
+func (c *Client) SiteGroups() *SiteGroupResource {
+	return NewResource[SiteGroup, CreateSiteGroupInput, UpdateSiteGroupInput, PatchSiteGroupInput, ListSiteGroupsInput](c, "dcim", "site-groups")
+}
+
+// ListSiteGroups lists all site groups matching the input criteria
 func (c *Client) ListSiteGroups(input *ListSiteGroupsInput) ([]SiteGroup, error) {
-	path := c.BuildPath("dcim", "site-groups")
-
-	// Build query parameters
-	params := map[string]string{}
-	if input.Name != "" {
-		params["name__ic"] = input.Name
-	}
-	if input.Parent != "" {
-		params["parent"] = input.Parent
-	}
-	if input.Tag != "" {
-		params["tag"] = input.Tag
-	}
-	if input.Limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", input.Limit)
-	}
-	if input.Offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", input.Offset)
-	}
-
-	// Make request
-	var response Response
-	response.Results = make([]any, 0)
-	_, err := c.R().
-		SetQueryParams(params).
-		SetResult(&response).
-		Get(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error listing site groups: %w", err)
-	}
-
-	// Convert results to []SiteGroup
-	siteGroups := make([]SiteGroup, len(response.Results))
-	for i, result := range response.Results {
-		resultMap, ok := result.(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("unexpected result type at index %d", i)
-		}
-
-		// Create a new SiteGroup
-		var siteGroup SiteGroup
-		err := convertMapToStruct(resultMap, &siteGroup)
-		if err != nil {
-			return nil, fmt.Errorf("error converting map to struct at index %d: %w", i, err)
-		}
-
-		siteGroups[i] = siteGroup
-	}
-
-	return siteGroups, nil
+	return c.ListSiteGroupsWithContext(context.Background(), input)
 }
 
-// GetSiteGroup retrieves a single site group by ID
-func (c *Client) GetSiteGroup(id int) (*SiteGroup, error) {
-	path := c.BuildPath("dcim", "site-groups", fmt.Sprintf("%d", id))
+// ListSiteGroupsWithContext lists all site groups matching the input criteria using the provided context
+func (c *Client) ListSiteGroupsWithContext(ctx context.Context, input *ListSiteGroupsInput) ([]SiteGroup, error) {
+	return c.SiteGroups().List(ctx, input)
+}
+
+// SiteGroupsPager returns a pager over all site groups matching the input criteria
+func (c *Client) SiteGroupsPager(input *ListSiteGroupsInput, opts *PagerOptions) *Pager[SiteGroup] {
+	return c.SiteGroups().Pager(input, opts)
+}
 
-	var siteGroup SiteGroup
-	resp, err := c.R().
-		SetResult(&siteGroup).
-		Get(path)
+// ListAllSiteGroups lists all site groups matching the input criteria, following pagination until exhausted
+func (c *Client) ListAllSiteGroups(input *ListSiteGroupsInput, opts *PagerOptions) ([]SiteGroup, error) {
+	return c.ListAllSiteGroupsWithContext(context.Background(), input, opts)
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("error getting site group: %w", err)
-	}
+// ListAllSiteGroupsWithContext lists all site groups matching the input criteria, following pagination until exhausted, using the provided context
+func (c *Client) ListAllSiteGroupsWithContext(ctx context.Context, input *ListSiteGroupsInput, opts *PagerOptions) ([]SiteGroup, error) {
+	return c.SiteGroups().ListAll(ctx, input, opts)
+}
 
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site group not found")
-	}
+// GetSiteGroup retrieves a single site group by ID
+func (c *Client) GetSiteGroup(id int) (*SiteGroup, error) {
+	return c.GetSiteGroupWithContext(context.Background(), id)
+}
 
-	return &siteGroup, nil
+// GetSiteGroupWithContext retrieves a single site group by ID using the provided context
+func (c *Client) GetSiteGroupWithContext(ctx context.Context, id int) (*SiteGroup, error) {
+	return c.SiteGroups().Get(ctx, id)
 }
 
 // CreateSiteGroup creates a new site group
 func (c *Client) CreateSiteGroup(input *CreateSiteGroupInput) (*SiteGroup, error) {
-	path := c.BuildPath("dcim", "site-groups")
-
-	var siteGroup SiteGroup
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&siteGroup).
-		Post(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating site group: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.CreateSiteGroupWithContext(context.Background(), input)
+}
 
-	return &siteGroup, nil
+// CreateSiteGroupWithContext creates a new site group using the provided context
+func (c *Client) CreateSiteGroupWithContext(ctx context.Context, input *CreateSiteGroupInput) (*SiteGroup, error) {
+	return c.SiteGroups().Create(ctx, input)
 }
 
 // UpdateSiteGroup updates an existing site group
 func (c *Client) UpdateSiteGroup(input *UpdateSiteGroupInput) (*SiteGroup, error) {
-	path := c.BuildPath("dcim", "site-groups", fmt.Sprintf("%d", input.ID))
-
-	var siteGroup SiteGroup
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&siteGroup).
-		Put(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error updating site group: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site group not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.UpdateSiteGroupWithContext(context.Background(), input)
+}
 
-	return &siteGroup, nil
+// UpdateSiteGroupWithContext updates an existing site group using the provided context
+func (c *Client) UpdateSiteGroupWithContext(ctx context.Context, input *UpdateSiteGroupInput) (*SiteGroup, error) {
+	return c.SiteGroups().Update(ctx, input.ID, input)
 }
 
 // PatchSiteGroup patches an existing site group
 func (c *Client) PatchSiteGroup(input *PatchSiteGroupInput) (*SiteGroup, error) {
-	path := c.BuildPath("dcim", "site-groups", fmt.Sprintf("%d", input.ID))
-
-	var siteGroup SiteGroup
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&siteGroup).
-		Patch(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error patching site group: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site group not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.PatchSiteGroupWithContext(context.Background(), input)
+}
 
-	return &siteGroup, nil
+// PatchSiteGroupWithContext patches an existing site group using the provided context
+func (c *Client) PatchSiteGroupWithContext(ctx context.Context, input *PatchSiteGroupInput) (*SiteGroup, error) {
+	return c.SiteGroups().Patch(ctx, input.ID, input)
 }
 
 // DeleteSiteGroup deletes a site group
 func (c *Client) DeleteSiteGroup(id int) error {
-	path := c.BuildPath("dcim", "site-groups", fmt.Sprintf("%d", id))
-
-	resp, err := c.R().
-		Delete(path)
-
-	if err != nil {
-		return fmt.Errorf("error deleting site group: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return fmt.Errorf("site group not found")
-	}
-
-	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.DeleteSiteGroupWithContext(context.Background(), id)
+}
 
-	return nil
+// DeleteSiteGroupWithContext deletes a site group using the provided context
+func (c *Client) DeleteSiteGroupWithContext(ctx context.Context, id int) error {
+	return c.SiteGroups().Delete(ctx, id)
 }
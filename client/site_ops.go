@@ -1,181 +1,93 @@
 package client
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
+// SiteResource is the typed resource for dcim/sites
+type SiteResource = Resource[Site, CreateSiteInput, UpdateSiteInput, PatchSiteInput, ListSitesInput]
+
+// Sites returns the typed resource for dcim/sites
+func (c *Client) Sites() *SiteResource {
+	return NewResource[Site, CreateSiteInput, UpdateSiteInput, PatchSiteInput, ListSitesInput](c, "dcim", "sites")
+}
+
 // ListSites lists all sites matching the input criteria
 func (c *Client) ListSites(input *ListSitesInput) ([]Site, error) {
-	path := c.BuildPath("dcim", "sites")
-
-	// Build query parameters
-	params := map[string]string{}
-	if input.Name != "" {
-		params["name__ic"] = input.Name
-	}
-	if input.Region != "" {
-		params["region"] = input.Region
-	}
-	if input.Status != "" {
-		params["status"] = input.Status
-	}
-	if input.Tag != "" {
-		params["tag"] = input.Tag
-	}
-	if input.Limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", input.Limit)
-	}
-	if input.Offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", input.Offset)
-	}
+	return c.ListSitesWithContext(context.Background(), input)
+}
 
-	// Make request
-	var response Response
-	response.Results = make([]any, 0)
-	_, err := c.R().
-		SetQueryParams(params).
-		SetResult(&response).
-		Get(path)
+// ListSitesWithContext lists all sites matching the input criteria using the provided context
+func (c *Client) ListSitesWithContext(ctx context.Context, input *ListSitesInput) ([]Site, error) {
+	return c.Sites().List(ctx, input)
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("error listing sites: %w", err)
-	}
+// SitesPager returns a pager over all sites matching the input criteria
+func (c *Client) SitesPager(input *ListSitesInput, opts *PagerOptions) *Pager[Site] {
+	return c.Sites().Pager(input, opts)
+}
 
-	// Convert results to []Site
-	sites := make([]Site, len(response.Results))
-	for i, result := range response.Results {
-		resultMap, ok := result.(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("unexpected result type at index %d", i)
-		}
-
-		// Create a new Site
-		var site Site
-		err := convertMapToStruct(resultMap, &site)
-		if err != nil {
-			return nil, fmt.Errorf("error converting map to struct at index %d: %w", i, err)
-		}
-
-		sites[i] = site
-	}
+// ListAllSites lists all sites matching the input criteria, following pagination until exhausted
+func (c *Client) ListAllSites(input *ListSitesInput, opts *PagerOptions) ([]Site, error) {
+	return c.ListAllSitesWithContext(context.Background(), input, opts)
+}
 
-	return sites, nil
+// ListAllSitesWithContext lists all sites matching the input criteria, following pagination until exhausted, using the provided context
+func (c *Client) ListAllSitesWithContext(ctx context.Context, input *ListSitesInput, opts *PagerOptions) ([]Site, error) {
+	return c.Sites().ListAll(ctx, input, opts)
 }
 
 // GetSite retrieves a single site by ID
 func (c *Client) GetSite(id int) (*Site, error) {
-	path := c.BuildPath("dcim", "sites", fmt.Sprintf("%d", id))
-
-	var site Site
-	resp, err := c.R().
-		SetResult(&site).
-		Get(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error getting site: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site not found")
-	}
+	return c.GetSiteWithContext(context.Background(), id)
+}
 
-	return &site, nil
+// GetSiteWithContext retrieves a single site by ID using the provided context
+func (c *Client) GetSiteWithContext(ctx context.Context, id int) (*Site, error) {
+	return c.Sites().Get(ctx, id)
 }
 
 // CreateSite creates a new site
 func (c *Client) CreateSite(input *CreateSiteInput) (*Site, error) {
-	path := c.BuildPath("dcim", "sites")
-
-	var site Site
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&site).
-		Post(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating site: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.CreateSiteWithContext(context.Background(), input)
+}
 
-	return &site, nil
+// CreateSiteWithContext creates a new site using the provided context
+func (c *Client) CreateSiteWithContext(ctx context.Context, input *CreateSiteInput) (*Site, error) {
+	return c.Sites().Create(ctx, input)
 }
 
 // UpdateSite updates an existing site
 func (c *Client) UpdateSite(input *UpdateSiteInput) (*Site, error) {
-	path := c.BuildPath("dcim", "sites", fmt.Sprintf("%d", input.ID))
-
-	var site Site
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&site).
-		Put(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error updating site: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.UpdateSiteWithContext(context.Background(), input)
+}
 
-	return &site, nil
+// UpdateSiteWithContext updates an existing site using the provided context
+func (c *Client) UpdateSiteWithContext(ctx context.Context, input *UpdateSiteInput) (*Site, error) {
+	return c.Sites().Update(ctx, input.ID, input)
 }
 
 // PatchSite patches an existing site
 func (c *Client) PatchSite(input *PatchSiteInput) (*Site, error) {
+	return c.PatchSiteWithContext(context.Background(), input)
+}
+
+// PatchSiteWithContext patches an existing site using the provided context
+func (c *Client) PatchSiteWithContext(ctx context.Context, input *PatchSiteInput) (*Site, error) {
 	if input.ID == nil {
 		return nil, fmt.Errorf("site ID is required")
 	}
 
-	path := c.BuildPath("dcim", "sites", fmt.Sprintf("%d", *input.ID))
-
-	var site Site
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&site).
-		Patch(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error patching site: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("site not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
-
-	return &site, nil
+	return c.Sites().Patch(ctx, *input.ID, input)
 }
 
 // DeleteSite deletes a site
 func (c *Client) DeleteSite(id int) error {
-	path := c.BuildPath("dcim", "sites", fmt.Sprintf("%d", id))
-
-	resp, err := c.R().
-		Delete(path)
-
-	if err != nil {
-		return fmt.Errorf("error deleting site: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return fmt.Errorf("site not found")
-	}
-
-	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.DeleteSiteWithContext(context.Background(), id)
+}
 
-	return nil
+// DeleteSiteWithContext deletes a site using the provided context
+func (c *Client) DeleteSiteWithContext(ctx context.Context, id int) error {
+	return c.Sites().Delete(ctx, id)
 }
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestSiteSchemaParity(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			query := r.URL.Query()
+			assert.Equal(t, []string{"2", "3"}, query["group_id"])
+			assert.Equal(t, "65001", query.Get("asn"))
+			assert.Equal(t, "Europe/London", query.Get("time_zone"))
+			assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("created__gte"))
+			_, _ = w.Write([]byte(`{"count": 1, "results": [{
+				"id": 1, "display": "LON1", "name": "LON1", "slug": "lon1",
+				"group": {"id": 2, "name": "Europe", "slug": "europe"},
+				"tenant": {"id": 5, "name": "Acme"},
+				"facility": "Equinix LD8", "time_zone": "Europe/London",
+				"asns": [{"id": 9, "display": "AS65001"}],
+				"tags": [{"id": 1, "name": "Core", "slug": "core", "color": "ff0000"}],
+				"device_count": 12, "rack_count": 4, "prefix_count": 7
+			}]}`))
+		case http.MethodPatch:
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []any{map[string]any{"slug": "core"}}, body["tags"])
+			assert.Equal(t, []any{float64(9)}, body["asns"])
+			_, _ = w.Write([]byte(`{"id": 1, "name": "LON1"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	sites, err := client.ListSites(&ListSitesInput{
+		GroupID:      []int{2, 3},
+		ASN:          []int{65001},
+		TimeZone:     []string{"Europe/London"},
+		CreatedAfter: "2024-01-01T00:00:00Z",
+	})
+	require.NoError(t, err)
+	require.Len(t, sites, 1)
+
+	site := sites[0]
+	assert.Equal(t, "LON1", site.Display)
+	assert.Equal(t, "europe", site.Group.Slug)
+	assert.Equal(t, "Acme", site.Tenant.Name)
+	assert.Equal(t, "Equinix LD8", site.Facility)
+	assert.Equal(t, "Europe/London", site.TimeZone)
+	assert.Equal(t, 9, site.ASNs[0].ID)
+	assert.Equal(t, "core", site.Tags[0].Slug)
+	assert.Equal(t, 12, site.DeviceCount)
+	assert.Equal(t, 4, site.RackCount)
+	assert.Equal(t, 7, site.PrefixCount)
+
+	id := 1
+	_, err = client.PatchSite(&PatchSiteInput{
+		ID:      &id,
+		AsnsIDs: &[]int{9},
+		Tags:    &[]models.TagCreate{{Slug: "core"}},
+	})
+	require.NoError(t, err)
+}
@@ -6,11 +6,11 @@ import (
 
 // ListTagsInput represents the input for listing tags
 type ListTagsInput struct {
-	Name   string
-	Slug   string
-	Color  string
-	Limit  int
-	Offset int
+	Name   string `query:"name__ic"`
+	Slug   string `query:"slug__ic"`
+	Color  string `query:"color__ic"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
 }
 
 // CreateTagInput represents the input for creating a tag
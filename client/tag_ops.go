@@ -1,176 +1,90 @@
 package client
 
 import (
-	"fmt"
-	"net/http"
+	"context"
 
 	"github.com/zeddD1abl0/go-netbox-client/models"
 )
 
-// ListTags lists all tags
+// TagResource is the typed resource for extras/tags
+type TagResource = Resource[models.Tag, CreateTagInput, UpdateTagInput, PatchTagInput, ListTagsInput]
+
+// Tags returns the typed resource for extras/tags
+func (c *Client) Tags() *TagResource {
+	return NewResource[models.Tag, CreateTagInput, UpdateTagInput, PatchTagInput, ListTagsInput](c, "extras", "tags")
+}
+
+// ListTags lists all tags matching the input criteria
 func (c *Client) ListTags(input *ListTagsInput) ([]models.Tag, error) {
-	path := c.BuildPath("extras", "tags")
-
-	// Build query parameters
-	params := map[string]string{}
-	if input.Name != "" {
-		params["name__ic"] = input.Name
-	}
-	if input.Slug != "" {
-		params["slug__ic"] = input.Slug
-	}
-	if input.Color != "" {
-		params["color__ic"] = input.Color
-	}
-	if input.Limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", input.Limit)
-	}
-	if input.Offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", input.Offset)
-	}
-
-	// Make request
-	var response Response
-	response.Results = make([]any, 0)
-	_, err := c.R().
-		SetQueryParams(params).
-		SetResult(&response).
-		Get(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error listing tags: %w", err)
-	}
-
-	// Convert results to []Tag
-	tags := make([]models.Tag, len(response.Results))
-	for i, result := range response.Results {
-		resultMap, ok := result.(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("unexpected result type at index %d", i)
-		}
-
-		// Create a new Tag
-		var tag models.Tag
-		err := convertMapToStruct(resultMap, &tag)
-		if err != nil {
-			return nil, fmt.Errorf("error converting map to struct at index %d: %w", i, err)
-		}
-
-		tags[i] = tag
-	}
-
-	return tags, nil
+	return c.ListTagsWithContext(context.Background(), input)
 }
 
-// GetTag retrieves a single tag by ID
-func (c *Client) GetTag(id int) (*models.Tag, error) {
-	path := c.BuildPath("extras", "tags", fmt.Sprintf("%d", id))
+// ListTagsWithContext lists all tags matching the input criteria using the provided context
+func (c *Client) ListTagsWithContext(ctx context.Context, input *ListTagsInput) ([]models.Tag, error) {
+	return c.Tags().List(ctx, input)
+}
+
+// TagsPager returns a pager over all tags matching the input criteria
+func (c *Client) TagsPager(input *ListTagsInput, opts *PagerOptions) *Pager[models.Tag] {
+	return c.Tags().Pager(input, opts)
+}
 
-	var tag models.Tag
-	resp, err := c.R().
-		SetResult(&tag).
-		Get(path)
+// ListAllTags lists all tags matching the input criteria, following pagination until exhausted
+func (c *Client) ListAllTags(input *ListTagsInput, opts *PagerOptions) ([]models.Tag, error) {
+	return c.ListAllTagsWithContext(context.Background(), input, opts)
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("error getting tag: %w", err)
-	}
+// ListAllTagsWithContext lists all tags matching the input criteria, following pagination until exhausted, using the provided context
+func (c *Client) ListAllTagsWithContext(ctx context.Context, input *ListTagsInput, opts *PagerOptions) ([]models.Tag, error) {
+	return c.Tags().ListAll(ctx, input, opts)
+}
 
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("tag not found")
-	}
+// GetTag retrieves a single tag by ID
+func (c *Client) GetTag(id int) (*models.Tag, error) {
+	return c.GetTagWithContext(context.Background(), id)
+}
 
-	return &tag, nil
+// GetTagWithContext retrieves a single tag by ID using the provided context
+func (c *Client) GetTagWithContext(ctx context.Context, id int) (*models.Tag, error) {
+	return c.Tags().Get(ctx, id)
 }
 
 // CreateTag creates a new tag
 func (c *Client) CreateTag(input *CreateTagInput) (*models.Tag, error) {
-	path := c.BuildPath("extras", "tags")
-
-	var tag models.Tag
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&tag).
-		Post(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error creating tag: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.CreateTagWithContext(context.Background(), input)
+}
 
-	return &tag, nil
+// CreateTagWithContext creates a new tag using the provided context
+func (c *Client) CreateTagWithContext(ctx context.Context, input *CreateTagInput) (*models.Tag, error) {
+	return c.Tags().Create(ctx, input)
 }
 
 // UpdateTag updates an existing tag
 func (c *Client) UpdateTag(input *UpdateTagInput) (*models.Tag, error) {
-	path := c.BuildPath("extras", "tags", fmt.Sprintf("%d", input.ID))
-
-	var tag models.Tag
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&tag).
-		Put(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error updating tag: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("tag not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.UpdateTagWithContext(context.Background(), input)
+}
 
-	return &tag, nil
+// UpdateTagWithContext updates an existing tag using the provided context
+func (c *Client) UpdateTagWithContext(ctx context.Context, input *UpdateTagInput) (*models.Tag, error) {
+	return c.Tags().Update(ctx, input.ID, input)
 }
 
 // PatchTag patches an existing tag
 func (c *Client) PatchTag(input *PatchTagInput) (*models.Tag, error) {
-	path := c.BuildPath("extras", "tags", fmt.Sprintf("%d", input.ID))
-
-	var tag models.Tag
-	resp, err := c.R().
-		SetBody(input).
-		SetResult(&tag).
-		Patch(path)
-
-	if err != nil {
-		return nil, fmt.Errorf("error patching tag: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return nil, fmt.Errorf("tag not found")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.PatchTagWithContext(context.Background(), input)
+}
 
-	return &tag, nil
+// PatchTagWithContext patches an existing tag using the provided context
+func (c *Client) PatchTagWithContext(ctx context.Context, input *PatchTagInput) (*models.Tag, error) {
+	return c.Tags().Patch(ctx, input.ID, input)
 }
 
 // DeleteTag deletes a tag
 func (c *Client) DeleteTag(id int) error {
-	path := c.BuildPath("extras", "tags", fmt.Sprintf("%d", id))
-
-	resp, err := c.R().
-		Delete(path)
-
-	if err != nil {
-		return fmt.Errorf("error deleting tag: %w", err)
-	}
-
-	if resp.StatusCode() == http.StatusNotFound {
-		return fmt.Errorf("tag not found")
-	}
-
-	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
-	}
+	return c.DeleteTagWithContext(context.Background(), id)
+}
 
-	return nil
+// DeleteTagWithContext deletes a tag using the provided context
+func (c *Client) DeleteTagWithContext(ctx context.Context, id int) error {
+	return c.Tags().Delete(ctx, id)
 }
@@ -39,9 +39,17 @@ func newTestServer(t *testing.T, code int, body interface{}) *testServer {
 	return ts
 }
 
+// newHandlerTestServer creates a new test server which serves every request
+// with the given handler. The server is closed when the test finishes.
+func newHandlerTestServer(t *testing.T, handler http.HandlerFunc) *testServer {
+	ts := &testServer{Server: httptest.NewServer(handler)}
+	t.Cleanup(ts.Close)
+	return ts
+}
+
 // newTestClient creates a new client that uses the test server
-func newTestClient(t *testing.T, ts *testServer) *Client {
-	client, err := NewClient(ts.URL, "test-token")
+func newTestClient(t *testing.T, ts *testServer, opts ...ClientOption) *Client {
+	client, err := NewClient(ts.URL, "test-token", opts...)
 	if err != nil {
 		t.Fatalf("failed to create test client: %v", err)
 	}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestValidationBeforeRequest(t *testing.T) {
+	lat := 120.0
+
+	tests := []struct {
+		name         string
+		opts         []ClientOption
+		wantRequests int
+		wantFields   []string
+	}{
+		{
+			name:         "invalid input is rejected locally",
+			wantRequests: 0,
+			wantFields:   []string{"slug", "status", "latitude"},
+		},
+		{
+			name:         "validation can be disabled",
+			opts:         []ClientOption{WithoutValidation()},
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			})
+
+			client := newTestClient(t, ts, tt.opts...)
+
+			_, err := client.CreateSite(&CreateSiteInput{
+				Name:     "Test Site",
+				Slug:     "not a slug",
+				Status:   "bogus",
+				Latitude: &lat,
+			})
+			assert.Equal(t, tt.wantRequests, requests)
+
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErrors models.ValidationErrors
+			require.True(t, errors.As(err, &validationErrors))
+			assert.True(t, IsValidation(err))
+
+			var fields []string
+			for _, e := range validationErrors {
+				fields = append(fields, e.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestPatchValidationAggregatesErrors(t *testing.T) {
+	client := NewClientForTesting(t)
+
+	_, err := client.PatchRegion(&PatchRegionInput{
+		Name: strPtr(""),
+		Slug: strPtr("bad slug"),
+	})
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+	assert.Len(t, validationErrors, 3)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// VirtualChassis represents a Netbox virtual chassis, a set of devices such
+// as stacked switches which are managed as one
+type VirtualChassis struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Domain       string         `json:"domain,omitempty"`
+	Master       *Device        `json:"master,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	MemberCount  int            `json:"member_count"`
+	Members      []Device       `json:"members,omitempty"`
+}
+
+// CreateVirtualChassisInput represents the input for creating a virtual
+// chassis. Netbox only accepts a master which is already a member, so set
+// Master once members have been added.
+type CreateVirtualChassisInput struct {
+	Name         string             `json:"name"`
+	Domain       string             `json:"domain,omitempty"`
+	Master       int                `json:"master,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateVirtualChassisInput
+func (input *CreateVirtualChassisInput) Validate() error {
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		return models.ValidationErrors{*err.(*models.ValidationError)}
+	}
+
+	return nil
+}
+
+// UpdateVirtualChassisInput represents the input for updating a virtual chassis
+type UpdateVirtualChassisInput CreateVirtualChassisInput
+
+// Validate validates the UpdateVirtualChassisInput
+func (input *UpdateVirtualChassisInput) Validate() error {
+	return (*CreateVirtualChassisInput)(input).Validate()
+}
+
+// PatchVirtualChassisInput represents the input for patching a virtual chassis
+type PatchVirtualChassisInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Domain       *string             `json:"domain,omitempty"`
+	Master       *int                `json:"master,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchVirtualChassisInput
+func (input *PatchVirtualChassisInput) Validate() error {
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			return models.ValidationErrors{*err.(*models.ValidationError)}
+		}
+	}
+
+	return nil
+}
+
+// AddVirtualChassisMemberInput represents the input for adding a device to a virtual chassis
+type AddVirtualChassisMemberInput struct {
+	VirtualChassis int  `json:"virtual_chassis"`
+	VCPosition     int  `json:"vc_position"`
+	VCPriority     *int `json:"vc_priority,omitempty"`
+}
+
+// Validate validates the AddVirtualChassisMemberInput
+func (input *AddVirtualChassisMemberInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.VirtualChassis == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "virtual_chassis",
+			Message: "Virtual chassis is required",
+		})
+	}
+
+	if err := models.ValidateRange("vc_position", float64(input.VCPosition), 0, 255); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.VCPriority != nil {
+		if err := models.ValidateRange("vc_priority", float64(*input.VCPriority), 0, 255); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListVirtualChassisInput represents the input for listing virtual chassis
+type ListVirtualChassisInput struct {
+	Query    string   `query:"q"`         // General search
+	Name     string   `query:"name__ic"`  // Filter by name (case-insensitive partial match)
+	Domain   []string `query:"domain"`    // Filter by domain
+	MasterID []int    `query:"master_id"` // Filter by master device ID
+	SiteID   []int    `query:"site_id"`   // Filter by site ID of the master device
+	TenantID []int    `query:"tenant_id"` // Filter by tenant ID of the master device
+	Tag      []string `query:"tag"`       // Filter by tag slug
+	Limit    int      `query:"limit"`     // Number of results to return per page
+	Offset   int      `query:"offset"`    // The initial index from which to return the results
+}
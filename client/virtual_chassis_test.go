@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualChassisMembership(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/dcim/devices/":
+			assert.Equal(t, "3", r.URL.Query().Get("virtual_chassis_id"))
+			_, _ = w.Write([]byte(`{"count": 2, "results": [
+				{"id": 2, "name": "sw2", "vc_position": 2},
+				{"id": 1, "name": "sw1", "vc_position": 1, "virtual_chassis": {"id": 3, "name": "stack1"}}
+			]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/dcim/devices/2/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if body["virtual_chassis"] == nil {
+				assert.Contains(t, body, "vc_position")
+				assert.Nil(t, body["vc_position"])
+				_, _ = w.Write([]byte(`{"id": 2, "name": "sw2"}`))
+				return
+			}
+			assert.Equal(t, float64(3), body["virtual_chassis"])
+			assert.Equal(t, float64(2), body["vc_position"])
+			assert.Equal(t, float64(100), body["vc_priority"])
+			_, _ = w.Write([]byte(`{"id": 2, "name": "sw2", "virtual_chassis": {"id": 3}, "vc_position": 2, "vc_priority": 100}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	priority := 100
+	device, err := client.AddVirtualChassisMember(2, &AddVirtualChassisMemberInput{VirtualChassis: 3, VCPosition: 2, VCPriority: &priority})
+	require.NoError(t, err)
+	assert.Equal(t, 3, device.VirtualChassis.ID)
+	assert.Equal(t, 2, *device.VCPosition)
+
+	members, err := client.ListVirtualChassisMembers(3)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "sw1", members[0].Name)
+	assert.Equal(t, "stack1", members[0].VirtualChassis.Name)
+
+	device, err = client.RemoveVirtualChassisMember(2)
+	require.NoError(t, err)
+	assert.Nil(t, device.VirtualChassis)
+
+	_, err = client.AddVirtualChassisMember(2, &AddVirtualChassisMemberInput{VCPosition: 300})
+	assert.True(t, IsValidation(err))
+}
+
+func TestCreateModuleInput(t *testing.T) {
+	replicate := false
+	input := &CreateModuleInput{Device: 1, ModuleBay: 2, ModuleType: 3, ReplicateComponents: &replicate, AdoptComponents: true}
+	require.NoError(t, input.Validate())
+
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"device": 1, "module_bay": 2, "module_type": 3, "replicate_components": false, "adopt_components": true}`, string(data))
+
+	assert.Error(t, (&CreateModuleInput{Device: 1, ModuleType: 3}).Validate())
+	assert.Error(t, (&CreateModuleInput{Device: 1, ModuleBay: 2, ModuleType: 3, Status: "broken"}).Validate())
+}
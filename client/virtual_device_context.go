@@ -0,0 +1,158 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for virtual device contexts
+const (
+	VirtualDeviceContextStatusActive  = "active"
+	VirtualDeviceContextStatusPlanned = "planned"
+	VirtualDeviceContextStatusOffline = "offline"
+)
+
+// virtualDeviceContextStatuses lists all valid virtual device context status values
+var virtualDeviceContextStatuses = []string{
+	VirtualDeviceContextStatusActive,
+	VirtualDeviceContextStatusPlanned,
+	VirtualDeviceContextStatusOffline,
+}
+
+// VirtualDeviceContext represents a Netbox virtual device context (VDC), a
+// logical partition of a device such as a Nexus VDC. Interfaces of the device
+// are assigned to one or more of its VDCs.
+type VirtualDeviceContext struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Name           string         `json:"name"`
+	Device         *Device        `json:"device"`
+	Identifier     *int           `json:"identifier,omitempty"`
+	Tenant         *NestedObject  `json:"tenant,omitempty"`
+	PrimaryIP      *IPAddress     `json:"primary_ip,omitempty"`
+	PrimaryIP4     *IPAddress     `json:"primary_ip4,omitempty"`
+	PrimaryIP6     *IPAddress     `json:"primary_ip6,omitempty"`
+	Status         *Status        `json:"status"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	InterfaceCount int            `json:"interface_count"`
+}
+
+// CreateVirtualDeviceContextInput represents the input for creating a virtual
+// device context. The identifier must be unique within the device.
+type CreateVirtualDeviceContextInput struct {
+	Name         string             `json:"name"`
+	Device       int                `json:"device"`
+	Identifier   *int               `json:"identifier,omitempty"`
+	Tenant       int                `json:"tenant,omitempty"`
+	PrimaryIP4   int                `json:"primary_ip4,omitempty"`
+	PrimaryIP6   int                `json:"primary_ip6,omitempty"`
+	Status       string             `json:"status"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateVirtualDeviceContextInput
+func (input *CreateVirtualDeviceContextInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Device == 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device",
+			Message: "Device is required",
+		})
+	}
+
+	if input.Identifier != nil {
+		if err := models.ValidateRange("identifier", float64(*input.Identifier), 0, 32767); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if err := models.ValidateOneOf("status", input.Status, virtualDeviceContextStatuses...); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateVirtualDeviceContextInput represents the input for updating a virtual device context
+type UpdateVirtualDeviceContextInput CreateVirtualDeviceContextInput
+
+// Validate validates the UpdateVirtualDeviceContextInput
+func (input *UpdateVirtualDeviceContextInput) Validate() error {
+	return (*CreateVirtualDeviceContextInput)(input).Validate()
+}
+
+// PatchVirtualDeviceContextInput represents the input for patching a virtual device context
+type PatchVirtualDeviceContextInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Device       *int                `json:"device,omitempty"`
+	Identifier   *int                `json:"identifier,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	PrimaryIP4   *int                `json:"primary_ip4,omitempty"`
+	PrimaryIP6   *int                `json:"primary_ip6,omitempty"`
+	Status       *string             `json:"status,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchVirtualDeviceContextInput
+func (input *PatchVirtualDeviceContextInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Identifier != nil {
+		if err := models.ValidateRange("identifier", float64(*input.Identifier), 0, 32767); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, virtualDeviceContextStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListVirtualDeviceContextsInput represents the input for listing virtual device contexts
+type ListVirtualDeviceContextsInput struct {
+	Query        string   `query:"q"`              // General search
+	Name         []string `query:"name"`           // Filter by name (exact match)
+	DeviceID     []int    `query:"device_id"`      // Filter by device ID
+	Device       []string `query:"device"`         // Filter by device name
+	Identifier   []int    `query:"identifier"`     // Filter by identifier
+	Status       []string `query:"status"`         // Filter by status
+	TenantID     []int    `query:"tenant_id"`      // Filter by tenant ID
+	HasPrimaryIP *bool    `query:"has_primary_ip"` // Filter by whether a primary IP is assigned
+	Tag          []string `query:"tag"`            // Filter by tag slug
+	Limit        int      `query:"limit"`          // Number of results to return per page
+	Offset       int      `query:"offset"`         // The initial index from which to return the results
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+)
+
+// VirtualDeviceContextResource is the typed resource for dcim/virtual-device-contexts
+type VirtualDeviceContextResource = Resource[VirtualDeviceContext, CreateVirtualDeviceContextInput, UpdateVirtualDeviceContextInput, PatchVirtualDeviceContextInput, ListVirtualDeviceContextsInput]
+
+// VirtualDeviceContexts returns the typed resource for dcim/virtual-device-contexts
+func (c *Client) VirtualDeviceContexts() *VirtualDeviceContextResource {
+	return NewResource[VirtualDeviceContext, CreateVirtualDeviceContextInput, UpdateVirtualDeviceContextInput, PatchVirtualDeviceContextInput, ListVirtualDeviceContextsInput](c, "dcim", "virtual-device-contexts")
+}
+
+// ListVirtualDeviceContextInterfaces lists the interfaces assigned to a virtual device context
+func (c *Client) ListVirtualDeviceContextInterfaces(vdcID int) ([]Interface, error) {
+	return c.ListVirtualDeviceContextInterfacesWithContext(context.Background(), vdcID)
+}
+
+// ListVirtualDeviceContextInterfacesWithContext lists the interfaces assigned to a virtual device context using the provided context
+func (c *Client) ListVirtualDeviceContextInterfacesWithContext(ctx context.Context, vdcID int) ([]Interface, error) {
+	return c.Interfaces().ListAll(ctx, &ListInterfacesInput{VDCID: []int{vdcID}}, nil)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestVirtualDeviceContexts(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/dcim/virtual-device-contexts/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, float64(1), body["identifier"])
+			assert.Equal(t, "active", body["status"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 4, "name": "admin", "device": {"id": 10, "name": "n7k-1"}, "identifier": 1,
+				"status": {"value": "active", "label": "Active"},
+				"primary_ip4": {"id": 20, "address": "192.0.2.10/24"}, "interface_count": 2}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/dcim/interfaces/":
+			assert.Equal(t, "4", r.URL.Query().Get("vdc_id"))
+			_, _ = w.Write([]byte(`{"count": 2, "results": [
+				{"id": 1, "name": "Ethernet1/1", "vdcs": [{"id": 4, "name": "admin"}]},
+				{"id": 2, "name": "Ethernet1/2", "vdcs": [{"id": 4, "name": "admin"}, {"id": 5, "name": "prod"}]}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+	ctx := context.Background()
+
+	identifier := 1
+	vdc, err := client.VirtualDeviceContexts().Create(ctx, &CreateVirtualDeviceContextInput{
+		Name:       "admin",
+		Device:     10,
+		Identifier: &identifier,
+		Status:     VirtualDeviceContextStatusActive,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "n7k-1", vdc.Device.Name)
+	assert.Equal(t, "192.0.2.10/24", vdc.PrimaryIP4.Address)
+	assert.Equal(t, 2, vdc.InterfaceCount)
+
+	interfaces, err := client.ListVirtualDeviceContextInterfacesWithContext(ctx, vdc.ID)
+	require.NoError(t, err)
+	require.Len(t, interfaces, 2)
+	assert.Equal(t, "prod", interfaces[1].VDCs[1].Name)
+}
+
+func TestVirtualDeviceContextValidation(t *testing.T) {
+	identifier := 40000
+	err := (&CreateVirtualDeviceContextInput{Name: "admin", Identifier: &identifier}).Validate()
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+
+	var fields []string
+	for _, e := range validationErrors {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"device", "identifier", "status"}, fields)
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Valid status values for VLANs
+const (
+	VLANStatusActive     = "active"
+	VLANStatusReserved   = "reserved"
+	VLANStatusDeprecated = "deprecated"
+)
+
+// vlanStatuses lists all valid VLAN status values
+var vlanStatuses = []string{
+	VLANStatusActive,
+	VLANStatusReserved,
+	VLANStatusDeprecated,
+}
+
+// VLAN represents a Netbox VLAN
+type VLAN struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Site         *Site          `json:"site,omitempty"`
+	Group        *VLANGroup     `json:"group,omitempty"`
+	VID          int            `json:"vid"`
+	Name         string         `json:"name"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	Status       *Status        `json:"status"`
+	Role         *NestedObject  `json:"role,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	PrefixCount  int            `json:"prefix_count"`
+}
+
+// CreateVLANInput represents the input for creating a VLAN
+type CreateVLANInput struct {
+	Site         int                `json:"site,omitempty"`
+	Group        int                `json:"group,omitempty"`
+	VID          int                `json:"vid"`
+	Name         string             `json:"name"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Role         int                `json:"role,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateVLANInput
+func (input *CreateVLANInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRange("vid", float64(input.VID), 1, 4094); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, vlanStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateVLANInput represents the input for updating a VLAN
+type UpdateVLANInput CreateVLANInput
+
+// Validate validates the UpdateVLANInput
+func (input *UpdateVLANInput) Validate() error {
+	return (*CreateVLANInput)(input).Validate()
+}
+
+// PatchVLANInput represents the input for patching a VLAN
+type PatchVLANInput struct {
+	Site         *int                `json:"site,omitempty"`
+	Group        *int                `json:"group,omitempty"`
+	VID          *int                `json:"vid,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Status       *string             `json:"status,omitempty"`
+	Role         *int                `json:"role,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchVLANInput
+func (input *PatchVLANInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.VID != nil {
+		if err := models.ValidateRange("vid", float64(*input.VID), 1, 4094); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Status != nil {
+		if err := models.ValidateOneOf("status", *input.Status, vlanStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListVLANsInput represents the input for listing VLANs. Slice fields match
+// any of the given values.
+type ListVLANsInput struct {
+	Query             string   `query:"q"`                   // General search
+	Name              string   `query:"name__ic"`            // Filter by name (case-insensitive partial match)
+	VID               []int    `query:"vid"`                 // Filter by VLAN ID
+	SiteID            []int    `query:"site_id"`             // Filter by site ID
+	GroupID           []int    `query:"group_id"`            // Filter by VLAN group ID
+	TenantID          []int    `query:"tenant_id"`           // Filter by tenant ID
+	RoleID            []int    `query:"role_id"`             // Filter by role ID
+	Status            []string `query:"status"`              // Filter by status
+	AvailableOnDevice int      `query:"available_on_device"` // Filter by VLANs available to the given device ID
+	Tag               []string `query:"tag"`                 // Filter by tag slug
+	Limit             int      `query:"limit"`               // Number of results to return per page
+	Offset            int      `query:"offset"`              // The initial index from which to return the results
+}
+
+// AvailableVLAN represents an unallocated VLAN ID within a VLAN group
+type AvailableVLAN struct {
+	VID   int        `json:"vid"`
+	Group *VLANGroup `json:"group,omitempty"`
+}
+
+// AllocateVLANInput represents the input for allocating the next free VLAN ID
+// from a VLAN group. The VID is assigned by Netbox.
+type AllocateVLANInput struct {
+	Name         string             `json:"name"`
+	Site         int                `json:"site,omitempty"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Role         int                `json:"role,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the AllocateVLANInput
+func (input *AllocateVLANInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if input.Status != "" {
+		if err := models.ValidateOneOf("status", input.Status, vlanStatuses...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// Object types a VLAN group can be scoped to
+const (
+	VLANGroupScopeRegion       = "dcim.region"
+	VLANGroupScopeSiteGroup    = "dcim.sitegroup"
+	VLANGroupScopeSite         = "dcim.site"
+	VLANGroupScopeLocation     = "dcim.location"
+	VLANGroupScopeRack         = "dcim.rack"
+	VLANGroupScopeClusterGroup = "virtualization.clustergroup"
+	VLANGroupScopeCluster      = "virtualization.cluster"
+)
+
+// vlanGroupScopes lists all valid VLAN group scope types
+var vlanGroupScopes = []string{
+	VLANGroupScopeRegion,
+	VLANGroupScopeSiteGroup,
+	VLANGroupScopeSite,
+	VLANGroupScopeLocation,
+	VLANGroupScopeRack,
+	VLANGroupScopeClusterGroup,
+	VLANGroupScopeCluster,
+}
+
+// VLANGroup represents a Netbox VLAN group
+type VLANGroup struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Slug         string         `json:"slug"`
+	ScopeType    string         `json:"scope_type,omitempty"`
+	ScopeID      *int           `json:"scope_id,omitempty"`
+	Scope        *NestedObject  `json:"scope,omitempty"`
+	VIDRanges    [][2]int       `json:"vid_ranges,omitempty"` // Requires Netbox 4.1 or later
+	Description  string         `json:"description,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+	VLANCount    int            `json:"vlan_count"`
+	Utilization  string         `json:"utilization"`
+}
+
+// CreateVLANGroupInput represents the input for creating a VLAN group. Use
+// ScopeType and ScopeID to scope the group to a region, site group, site or location.
+// VIDRanges is only accepted by Netbox 4.1 or later; earlier versions use a
+// single min_vid/max_vid range.
+type CreateVLANGroupInput struct {
+	Name         string             `json:"name"`
+	Slug         string             `json:"slug"`
+	ScopeType    string             `json:"scope_type,omitempty"`
+	ScopeID      int                `json:"scope_id,omitempty"`
+	VIDRanges    [][2]int           `json:"vid_ranges,omitempty"` // Requires Netbox 4.1 or later
+	Description  string             `json:"description,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateVLANGroupInput
+func (input *CreateVLANGroupInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateSlug(input.Slug); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	errors = append(errors, validateVLANGroupScope(input.ScopeType, input.ScopeID != 0)...)
+	errors = append(errors, validateVIDRanges(input.VIDRanges)...)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateVLANGroupInput represents the input for updating a VLAN group
+type UpdateVLANGroupInput CreateVLANGroupInput
+
+// Validate validates the UpdateVLANGroupInput
+func (input *UpdateVLANGroupInput) Validate() error {
+	return (*CreateVLANGroupInput)(input).Validate()
+}
+
+// PatchVLANGroupInput represents the input for patching a VLAN group
+type PatchVLANGroupInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	ScopeType    *string             `json:"scope_type,omitempty"`
+	ScopeID      *int                `json:"scope_id,omitempty"`
+	VIDRanges    *[][2]int           `json:"vid_ranges,omitempty"` // Requires Netbox 4.1 or later
+	Description  *string             `json:"description,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchVLANGroupInput
+func (input *PatchVLANGroupInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.Slug != nil {
+		if err := models.ValidateSlug(*input.Slug); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.ScopeType != nil {
+		errors = append(errors, validateVLANGroupScope(*input.ScopeType, input.ScopeID != nil)...)
+	}
+
+	if input.VIDRanges != nil {
+		errors = append(errors, validateVIDRanges(*input.VIDRanges)...)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateVLANGroupScope checks that the scope type is valid and comes with a scope ID
+func validateVLANGroupScope(scopeType string, hasScopeID bool) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if scopeType != "" {
+		if err := models.ValidateOneOf("scope_type", scopeType, vlanGroupScopes...); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if hasScopeID && scopeType == "" {
+		errors = append(errors, models.ValidationError{
+			Field:   "scope_id",
+			Message: "requires scope_type to be set",
+		})
+	}
+
+	return errors
+}
+
+// validateVIDRanges checks that every VID range is within 1-4094 and ordered
+func validateVIDRanges(ranges [][2]int) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	for _, r := range ranges {
+		if r[0] < 1 || r[1] > 4094 || r[0] > r[1] {
+			errors = append(errors, models.ValidationError{
+				Field:   "vid_ranges",
+				Message: "ranges must be between 1 and 4094 with the lower bound first",
+			})
+			break
+		}
+	}
+
+	return errors
+}
+
+// ListVLANGroupsInput represents the input for listing VLAN groups
+type ListVLANGroupsInput struct {
+	Query     string   `query:"q"`          // General search
+	Name      string   `query:"name__ic"`   // Filter by name (case-insensitive partial match)
+	Slug      []string `query:"slug"`       // Filter by slug
+	ScopeType string   `query:"scope_type"` // Filter by scope type
+	ScopeID   []int    `query:"scope_id"`   // Filter by scope ID
+	Region    int      `query:"region"`     // Filter by region ID, including groups scoped to its sites
+	SiteGroup int      `query:"sitegroup"`  // Filter by site group ID
+	Site      int      `query:"site"`       // Filter by site ID
+	Location  int      `query:"location"`   // Filter by location ID
+	Tag       []string `query:"tag"`        // Filter by tag slug
+	Limit     int      `query:"limit"`      // Number of results to return per page
+	Offset    int      `query:"offset"`     // The initial index from which to return the results
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// VLANResource is the typed resource for ipam/vlans
+type VLANResource = Resource[VLAN, CreateVLANInput, UpdateVLANInput, PatchVLANInput, ListVLANsInput]
+
+// VLANs returns the typed resource for ipam/vlans
+func (c *Client) VLANs() *VLANResource {
+	return NewResource[VLAN, CreateVLANInput, UpdateVLANInput, PatchVLANInput, ListVLANsInput](c, "ipam", "vlans")
+}
+
+// VLANGroupResource is the typed resource for ipam/vlan-groups
+type VLANGroupResource = Resource[VLANGroup, CreateVLANGroupInput, UpdateVLANGroupInput, PatchVLANGroupInput, ListVLANGroupsInput]
+
+// VLANGroups returns the typed resource for ipam/vlan-groups
+func (c *Client) VLANGroups() *VLANGroupResource {
+	return NewResource[VLANGroup, CreateVLANGroupInput, UpdateVLANGroupInput, PatchVLANGroupInput, ListVLANGroupsInput](c, "ipam", "vlan-groups")
+}
+
+// ListAvailableVLANs lists the unallocated VLAN IDs in a VLAN group
+func (c *Client) ListAvailableVLANs(groupID int) ([]AvailableVLAN, error) {
+	return c.ListAvailableVLANsWithContext(context.Background(), groupID)
+}
+
+// ListAvailableVLANsWithContext lists the unallocated VLAN IDs in a VLAN group using the provided context
+func (c *Client) ListAvailableVLANsWithContext(ctx context.Context, groupID int) ([]AvailableVLAN, error) {
+	path := c.BuildPath("ipam", "vlan-groups", fmt.Sprintf("%d", groupID), "available-vlans")
+
+	available := make([]AvailableVLAN, 0)
+	resp, err := c.RWithContext(ctx).
+		SetResult(&available).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing available VLANs: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return available, nil
+}
+
+// AllocateVLAN reserves the next free VLAN ID in a VLAN group and creates a VLAN with it
+func (c *Client) AllocateVLAN(groupID int, input *AllocateVLANInput) (*VLAN, error) {
+	return c.AllocateVLANWithContext(context.Background(), groupID, input)
+}
+
+// AllocateVLANWithContext reserves the next free VLAN ID in a VLAN group and creates a VLAN with it using the provided context
+func (c *Client) AllocateVLANWithContext(ctx context.Context, groupID int, input *AllocateVLANInput) (*VLAN, error) {
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	path := c.BuildPath("ipam", "vlan-groups", fmt.Sprintf("%d", groupID), "available-vlans")
+
+	var vlan VLAN
+	resp, err := c.RWithContext(ctx).
+		SetBody(input).
+		SetResult(&vlan).
+		Post(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error allocating VLAN: %w", err)
+	}
+
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &vlan, nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateVLAN(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/ipam/vlan-groups/4/available-vlans/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[{"vid": 101, "group": {"id": 4, "name": "fabric"}}, {"vid": 102, "group": {"id": 4, "name": "fabric"}}]`))
+			return
+		}
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "servers", body["name"])
+		assert.NotContains(t, body, "vid")
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 12, "vid": 101, "name": "servers", "group": {"id": 4}, "status": {"value": "active"}}`))
+	})
+
+	client := newTestClient(t, ts)
+
+	available, err := client.ListAvailableVLANs(4)
+	require.NoError(t, err)
+	require.Len(t, available, 2)
+	assert.Equal(t, 101, available[0].VID)
+	assert.Equal(t, "fabric", available[0].Group.Name)
+
+	vlan, err := client.AllocateVLAN(4, &AllocateVLANInput{Name: "servers", Status: VLANStatusActive})
+	require.NoError(t, err)
+	assert.Equal(t, 101, vlan.VID)
+	assert.Equal(t, 4, vlan.Group.ID)
+}
+
+func TestCreateVLANGroupInputValidate(t *testing.T) {
+	assert.NoError(t, (&CreateVLANGroupInput{
+		Name:      "Fabric",
+		Slug:      "fabric",
+		ScopeType: VLANGroupScopeSite,
+		ScopeID:   1,
+		VIDRanges: [][2]int{{100, 199}, {300, 399}},
+	}).Validate())
+
+	assert.Error(t, (&CreateVLANGroupInput{Name: "Fabric", Slug: "fabric", ScopeID: 1}).Validate())
+	assert.Error(t, (&CreateVLANGroupInput{Name: "Fabric", Slug: "fabric", ScopeType: "dcim.device", ScopeID: 1}).Validate())
+	assert.Error(t, (&CreateVLANGroupInput{Name: "Fabric", Slug: "fabric", VIDRanges: [][2]int{{200, 100}}}).Validate())
+}
@@ -0,0 +1,273 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+// VRF represents a Netbox VRF (virtual routing and forwarding instance). A
+// VRF with EnforceUnique set rejects duplicate prefixes and IP addresses.
+type VRF struct {
+	ID             int            `json:"id"`
+	URL            string         `json:"url"`
+	Display        string         `json:"display"`
+	Name           string         `json:"name"`
+	RD             *string        `json:"rd,omitempty"`
+	Tenant         *NestedObject  `json:"tenant,omitempty"`
+	EnforceUnique  bool           `json:"enforce_unique"`
+	Description    string         `json:"description,omitempty"`
+	Comments       string         `json:"comments,omitempty"`
+	ImportTargets  []RouteTarget  `json:"import_targets,omitempty"`
+	ExportTargets  []RouteTarget  `json:"export_targets,omitempty"`
+	Tags           []models.Tag   `json:"tags,omitempty"`
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	Created        string         `json:"created"`
+	LastUpdated    string         `json:"last_updated"`
+	IPAddressCount int            `json:"ipaddress_count"`
+	PrefixCount    int            `json:"prefix_count"`
+}
+
+// CreateVRFInput represents the input for creating a VRF. Netbox enforces
+// unique space within a VRF unless EnforceUnique is set to false.
+type CreateVRFInput struct {
+	Name          string             `json:"name"`
+	RD            string             `json:"rd,omitempty"`
+	Tenant        int                `json:"tenant,omitempty"`
+	EnforceUnique *bool              `json:"enforce_unique,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Comments      string             `json:"comments,omitempty"`
+	ImportTargets []int              `json:"import_targets,omitempty"`
+	ExportTargets []int              `json:"export_targets,omitempty"`
+	Tags          []models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateVRFInput
+func (input *CreateVRFInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateMaxLength("rd", input.RD, 21); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateVRFInput represents the input for updating a VRF
+type UpdateVRFInput CreateVRFInput
+
+// Validate validates the UpdateVRFInput
+func (input *UpdateVRFInput) Validate() error {
+	return (*CreateVRFInput)(input).Validate()
+}
+
+// PatchVRFInput represents the input for patching a VRF
+type PatchVRFInput struct {
+	Name          *string             `json:"name,omitempty"`
+	RD            *string             `json:"rd,omitempty"`
+	Tenant        *int                `json:"tenant,omitempty"`
+	EnforceUnique *bool               `json:"enforce_unique,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Comments      *string             `json:"comments,omitempty"`
+	ImportTargets *[]int              `json:"import_targets,omitempty"`
+	ExportTargets *[]int              `json:"export_targets,omitempty"`
+	Tags          *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields  map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchVRFInput
+func (input *PatchVRFInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if input.RD != nil {
+		if err := models.ValidateMaxLength("rd", *input.RD, 21); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListVRFsInput represents the input for listing VRFs
+type ListVRFsInput struct {
+	Query          string   `query:"q"`                // General search
+	Name           []string `query:"name"`             // Filter by name (exact match)
+	RD             []string `query:"rd"`               // Filter by route distinguisher
+	TenantID       []int    `query:"tenant_id"`        // Filter by tenant ID
+	EnforceUnique  *bool    `query:"enforce_unique"`   // Filter by whether unique space is enforced
+	ImportTargetID []int    `query:"import_target_id"` // Filter by import route target ID
+	ImportTarget   []string `query:"import_target"`    // Filter by import route target name
+	ExportTargetID []int    `query:"export_target_id"` // Filter by export route target ID
+	ExportTarget   []string `query:"export_target"`    // Filter by export route target name
+	Tag            []string `query:"tag"`              // Filter by tag slug
+	Limit          int      `query:"limit"`            // Number of results to return per page
+	Offset         int      `query:"offset"`           // The initial index from which to return the results
+}
+
+// RouteTarget represents a Netbox route target, a BGP extended community
+// such as "65000:100" imported or exported by VRFs
+type RouteTarget struct {
+	ID           int            `json:"id"`
+	URL          string         `json:"url"`
+	Display      string         `json:"display"`
+	Name         string         `json:"name"`
+	Tenant       *NestedObject  `json:"tenant,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Comments     string         `json:"comments,omitempty"`
+	Tags         []models.Tag   `json:"tags,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+	Created      string         `json:"created"`
+	LastUpdated  string         `json:"last_updated"`
+}
+
+// CreateRouteTargetInput represents the input for creating a route target
+type CreateRouteTargetInput struct {
+	Name         string             `json:"name"`
+	Tenant       int                `json:"tenant,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Comments     string             `json:"comments,omitempty"`
+	Tags         []models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the CreateRouteTargetInput
+func (input *CreateRouteTargetInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("name", input.Name); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateMaxLength("name", input.Name, 21); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// UpdateRouteTargetInput represents the input for updating a route target
+type UpdateRouteTargetInput CreateRouteTargetInput
+
+// Validate validates the UpdateRouteTargetInput
+func (input *UpdateRouteTargetInput) Validate() error {
+	return (*CreateRouteTargetInput)(input).Validate()
+}
+
+// PatchRouteTargetInput represents the input for patching a route target
+type PatchRouteTargetInput struct {
+	Name         *string             `json:"name,omitempty"`
+	Tenant       *int                `json:"tenant,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Comments     *string             `json:"comments,omitempty"`
+	Tags         *[]models.TagCreate `json:"tags,omitempty"`
+	CustomFields map[string]any      `json:"custom_fields,omitempty"`
+}
+
+// Validate validates the PatchRouteTargetInput
+func (input *PatchRouteTargetInput) Validate() error {
+	var errors models.ValidationErrors
+
+	if input.Name != nil {
+		if err := models.ValidateRequired("name", *input.Name); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+
+		if err := models.ValidateMaxLength("name", *input.Name, 21); err != nil {
+			errors = append(errors, *err.(*models.ValidationError))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// ListRouteTargetsInput represents the input for listing route targets
+type ListRouteTargetsInput struct {
+	Query          string   `query:"q"`                // General search
+	Name           []string `query:"name"`             // Filter by name (exact match)
+	TenantID       []int    `query:"tenant_id"`        // Filter by tenant ID
+	ImportingVRFID []int    `query:"importing_vrf_id"` // Filter by ID of a VRF importing the target
+	ExportingVRFID []int    `query:"exporting_vrf_id"` // Filter by ID of a VRF exporting the target
+	Tag            []string `query:"tag"`              // Filter by tag slug
+	Limit          int      `query:"limit"`            // Number of results to return per page
+	Offset         int      `query:"offset"`           // The initial index from which to return the results
+}
+
+// CreateVRFWithPrefixesInput represents the input for creating a VRF together
+// with its route targets and child prefixes. Route targets are matched by
+// name and only created if they do not exist yet; the VRF of each prefix is
+// set to the new VRF.
+type CreateVRFWithPrefixesInput struct {
+	VRF           CreateVRFInput
+	ImportTargets []CreateRouteTargetInput
+	ExportTargets []CreateRouteTargetInput
+	Prefixes      []CreatePrefixInput
+}
+
+// Validate validates the CreateVRFWithPrefixesInput. Errors of the route
+// targets and prefixes are reported with their index, e.g. "prefixes[1].prefix".
+func (input *CreateVRFWithPrefixesInput) Validate() error {
+	var errors models.ValidationErrors
+
+	collect := func(prefix string, v models.Validator) {
+		nested, _ := v.Validate().(models.ValidationErrors)
+		for _, err := range nested {
+			if prefix != "" {
+				err.Field = prefix + "." + err.Field
+			}
+			errors = append(errors, err)
+		}
+	}
+
+	collect("", &input.VRF)
+	for i := range input.ImportTargets {
+		collect(fmt.Sprintf("import_targets[%d]", i), &input.ImportTargets[i])
+	}
+	for i := range input.ExportTargets {
+		collect(fmt.Sprintf("export_targets[%d]", i), &input.ExportTargets[i])
+	}
+	for i := range input.Prefixes {
+		collect(fmt.Sprintf("prefixes[%d]", i), &input.Prefixes[i])
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// VRFWithPrefixes is the result of creating a VRF with its route targets and
+// child prefixes. RouteTargets only holds the route targets which were
+// created; all of them, including reused ones, are available on the VRF.
+type VRFWithPrefixes struct {
+	RouteTargets []RouteTarget
+	VRF          *VRF
+	Prefixes     []Prefix
+}
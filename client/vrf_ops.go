@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// VRFResource is the typed resource for ipam/vrfs
+type VRFResource = Resource[VRF, CreateVRFInput, UpdateVRFInput, PatchVRFInput, ListVRFsInput]
+
+// VRFs returns the typed resource for ipam/vrfs
+func (c *Client) VRFs() *VRFResource {
+	return NewResource[VRF, CreateVRFInput, UpdateVRFInput, PatchVRFInput, ListVRFsInput](c, "ipam", "vrfs")
+}
+
+// RouteTargetResource is the typed resource for ipam/route-targets
+type RouteTargetResource = Resource[RouteTarget, CreateRouteTargetInput, UpdateRouteTargetInput, PatchRouteTargetInput, ListRouteTargetsInput]
+
+// RouteTargets returns the typed resource for ipam/route-targets
+func (c *Client) RouteTargets() *RouteTargetResource {
+	return NewResource[RouteTarget, CreateRouteTargetInput, UpdateRouteTargetInput, PatchRouteTargetInput, ListRouteTargetsInput](c, "ipam", "route-targets")
+}
+
+// CreateVRFWithPrefixes creates a VRF importing and exporting the given route
+// targets, then creates its child prefixes. Existing route targets are reused.
+// If a step fails, the route targets, VRF and prefixes created before the
+// failure are returned with the error so that they can be cleaned up.
+func (c *Client) CreateVRFWithPrefixes(input *CreateVRFWithPrefixesInput) (*VRFWithPrefixes, error) {
+	return c.CreateVRFWithPrefixesWithContext(context.Background(), input)
+}
+
+// CreateVRFWithPrefixesWithContext creates a VRF with its route targets and child prefixes using the provided context
+func (c *Client) CreateVRFWithPrefixesWithContext(ctx context.Context, input *CreateVRFWithPrefixesInput) (*VRFWithPrefixes, error) {
+	if err := c.validate(input); err != nil {
+		return nil, err
+	}
+
+	result := &VRFWithPrefixes{}
+	targets := make(map[string]int)
+	importTargets, err := c.ensureRouteTargets(ctx, input.ImportTargets, targets, &result.RouteTargets)
+	if err != nil {
+		return result, err
+	}
+	exportTargets, err := c.ensureRouteTargets(ctx, input.ExportTargets, targets, &result.RouteTargets)
+	if err != nil {
+		return result, err
+	}
+
+	vrfInput := input.VRF
+	vrfInput.ImportTargets = append(append([]int(nil), vrfInput.ImportTargets...), importTargets...)
+	vrfInput.ExportTargets = append(append([]int(nil), vrfInput.ExportTargets...), exportTargets...)
+
+	vrf, err := c.VRFs().Create(ctx, &vrfInput)
+	if err != nil {
+		return result, fmt.Errorf("error creating VRF %s: %w", input.VRF.Name, err)
+	}
+
+	result.VRF = vrf
+	for _, prefixInput := range input.Prefixes {
+		prefixInput.VRF = vrf.ID
+
+		prefix, err := c.Prefixes().Create(ctx, &prefixInput)
+		if err != nil {
+			return result, fmt.Errorf("error creating prefix %s in VRF %s: %w", prefixInput.Prefix, vrf.Name, err)
+		}
+		result.Prefixes = append(result.Prefixes, *prefix)
+	}
+
+	return result, nil
+}
+
+// ensureRouteTargets returns the IDs of the given route targets, creating
+// those that do not exist and appending them to created. Known maps names to
+// IDs already resolved.
+func (c *Client) ensureRouteTargets(ctx context.Context, inputs []CreateRouteTargetInput, known map[string]int, created *[]RouteTarget) ([]int, error) {
+	ids := make([]int, 0, len(inputs))
+	for i := range inputs {
+		input := &inputs[i]
+
+		if id, ok := known[input.Name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+
+		existing, err := c.RouteTargets().List(ctx, &ListRouteTargetsInput{Name: []string{input.Name}, Limit: 1})
+		if err != nil {
+			return nil, fmt.Errorf("error looking up route target %s: %w", input.Name, err)
+		}
+
+		var id int
+		if len(existing) > 0 {
+			id = existing[0].ID
+		} else {
+			target, err := c.RouteTargets().Create(ctx, input)
+			if err != nil {
+				return nil, fmt.Errorf("error creating route target %s: %w", input.Name, err)
+			}
+			id = target.ID
+			*created = append(*created, *target)
+		}
+
+		known[input.Name] = id
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+func TestCreateVRFWithPrefixes(t *testing.T) {
+	var createdTargets, createdPrefixes []string
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/route-targets/":
+			if r.URL.Query().Get("name") == "65000:1" {
+				_, _ = w.Write([]byte(`{"count": 1, "results": [{"id": 1, "name": "65000:1"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/route-targets/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			createdTargets = append(createdTargets, body["name"].(string))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `{"id": %d, "name": %q}`, len(createdTargets)+1, body["name"])
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/vrfs/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []any{float64(1)}, body["import_targets"])
+			assert.Equal(t, []any{float64(1), float64(2)}, body["export_targets"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 7, "name": "CUST-A", "rd": "65000:100",
+				"import_targets": [{"id": 1, "name": "65000:1"}],
+				"export_targets": [{"id": 1, "name": "65000:1"}, {"id": 2, "name": "65000:2"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/prefixes/":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, float64(7), body["vrf"])
+			createdPrefixes = append(createdPrefixes, body["prefix"].(string))
+			if body["prefix"] == "10.0.1.0/24" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"prefix": ["Duplicate prefix found in VRF CUST-A"]}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `{"id": %d, "prefix": %q, "vrf": {"id": 7, "name": "CUST-A"}}`, len(createdPrefixes)+10, body["prefix"])
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	input := &CreateVRFWithPrefixesInput{
+		VRF:           CreateVRFInput{Name: "CUST-A", RD: "65000:100"},
+		ImportTargets: []CreateRouteTargetInput{{Name: "65000:1"}},
+		ExportTargets: []CreateRouteTargetInput{{Name: "65000:1"}, {Name: "65000:2"}},
+		Prefixes:      []CreatePrefixInput{{Prefix: "10.0.0.0/24"}, {Prefix: "10.0.1.0/24"}, {Prefix: "10.0.2.0/24"}},
+	}
+
+	result, err := client.CreateVRFWithPrefixes(input)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "10.0.1.0/24")
+
+	// the objects created before the failure are returned
+	require.NotNil(t, result)
+	require.Len(t, result.RouteTargets, 1)
+	assert.Equal(t, "65000:2", result.RouteTargets[0].Name)
+	assert.Equal(t, 7, result.VRF.ID)
+	assert.Len(t, result.VRF.ExportTargets, 2)
+	require.Len(t, result.Prefixes, 1)
+	assert.Equal(t, "CUST-A", result.Prefixes[0].VRF.Name)
+
+	assert.Equal(t, []string{"65000:2"}, createdTargets)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.1.0/24"}, createdPrefixes)
+	assert.Zero(t, input.Prefixes[0].VRF, "input must not be modified")
+}
+
+func TestCreateVRFWithPrefixesVRFFailure(t *testing.T) {
+	ts := newHandlerTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/route-targets/":
+			_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/route-targets/":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 3, "name": "65000:3"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/vrfs/":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"rd": ["VRF with this Route distinguisher already exists."]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	client := newTestClient(t, ts)
+
+	result, err := client.CreateVRFWithPrefixes(&CreateVRFWithPrefixesInput{
+		VRF:           CreateVRFInput{Name: "CUST-B", RD: "65000:100"},
+		ImportTargets: []CreateRouteTargetInput{{Name: "65000:3"}},
+		Prefixes:      []CreatePrefixInput{{Prefix: "10.1.0.0/24"}},
+	})
+	require.Error(t, err)
+
+	// the route target created before the failure is returned
+	require.NotNil(t, result)
+	assert.Nil(t, result.VRF)
+	require.Len(t, result.RouteTargets, 1)
+	assert.Equal(t, 3, result.RouteTargets[0].ID)
+	assert.Empty(t, result.Prefixes)
+}
+
+func TestCreateVRFWithPrefixesValidation(t *testing.T) {
+	client := NewClientForTesting(t)
+
+	_, err := client.CreateVRFWithPrefixes(&CreateVRFWithPrefixesInput{
+		VRF:           CreateVRFInput{Name: "CUST-A"},
+		ExportTargets: []CreateRouteTargetInput{{Name: "65000:1"}, {Name: ""}},
+		Prefixes:      []CreatePrefixInput{{Prefix: "10.0.0.0/24"}, {Prefix: "10.0.1.0"}},
+	})
+
+	var validationErrors models.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+
+	var fields []string
+	for _, e := range validationErrors {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"export_targets[1].name", "prefixes[1].prefix"}, fields)
+}
+
+func TestAggregateDateValidation(t *testing.T) {
+	assert.NoError(t, (&CreateAggregateInput{Prefix: "10.0.0.0/8", RIR: 1, DateAdded: "2024-02-29"}).Validate())
+	assert.Error(t, (&CreateAggregateInput{Prefix: "10.0.0.0/8", RIR: 1, DateAdded: "2023-02-29"}).Validate())
+	assert.Error(t, (&PatchAggregateInput{DateAdded: strPtr("29/02/2024")}).Validate())
+}
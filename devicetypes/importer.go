@@ -0,0 +1,439 @@
+package devicetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeddD1abl0/go-netbox-client/client"
+)
+
+// Object types recorded in import reports
+const (
+	ObjectTypeManufacturer              = "dcim.manufacturer"
+	ObjectTypeDeviceType                = "dcim.devicetype"
+	ObjectTypeModuleType                = "dcim.moduletype"
+	ObjectTypeInterfaceTemplate         = "dcim.interfacetemplate"
+	ObjectTypeConsolePortTemplate       = "dcim.consoleporttemplate"
+	ObjectTypeConsoleServerPortTemplate = "dcim.consoleserverporttemplate"
+	ObjectTypePowerPortTemplate         = "dcim.powerporttemplate"
+	ObjectTypePowerOutletTemplate       = "dcim.poweroutlettemplate"
+	ObjectTypeFrontPortTemplate         = "dcim.frontporttemplate"
+	ObjectTypeRearPortTemplate          = "dcim.rearporttemplate"
+	ObjectTypeModuleBayTemplate         = "dcim.modulebaytemplate"
+	ObjectTypeDeviceBayTemplate         = "dcim.devicebaytemplate"
+)
+
+// Object identifies a Netbox object visited during an import
+type Object struct {
+	Type string
+	ID   int
+	Name string
+}
+
+// Report records which objects an import created and which were already present
+type Report struct {
+	Created  []Object
+	Existing []Object
+}
+
+// Merge appends the objects of another report to this one
+func (r *Report) Merge(other *Report) {
+	r.Created = append(r.Created, other.Created...)
+	r.Existing = append(r.Existing, other.Existing...)
+}
+
+// record adds an object to the created or existing list
+func (r *Report) record(created bool, objectType string, id int, name string) {
+	object := Object{Type: objectType, ID: id, Name: name}
+	if created {
+		r.Created = append(r.Created, object)
+	} else {
+		r.Existing = append(r.Existing, object)
+	}
+}
+
+// Importer creates device types, module types and their component templates
+// in Netbox. Imports are idempotent: manufacturers are matched by name or
+// slug, device types by model or slug, module types by model and templates by
+// component name, and only missing objects are created. Existing objects are
+// never modified.
+type Importer struct {
+	client *client.Client
+}
+
+// NewImporter creates an importer which writes through the given client
+func NewImporter(c *client.Client) *Importer {
+	return &Importer{client: c}
+}
+
+// ImportDeviceType creates a device type, its manufacturer and its component
+// templates, skipping any which already exist
+func (i *Importer) ImportDeviceType(ctx context.Context, definition *DeviceType) (*Report, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+
+	manufacturerID, err := i.ensureManufacturer(ctx, definition.Manufacturer, report)
+	if err != nil {
+		return report, err
+	}
+
+	slug := definition.Slug
+	if slug == "" {
+		slug = slugify(definition.Model)
+	}
+
+	existing, err := i.findDeviceType(ctx, manufacturerID, definition.Model, slug)
+	if err != nil {
+		return report, err
+	}
+
+	var deviceTypeID int
+	if existing != nil {
+		deviceTypeID = existing.ID
+		report.record(false, ObjectTypeDeviceType, deviceTypeID, definition.Model)
+	} else {
+		created, err := i.client.DeviceTypes().Create(ctx, &client.CreateDeviceTypeInput{
+			Manufacturer:           manufacturerID,
+			Model:                  definition.Model,
+			Slug:                   slug,
+			PartNumber:             definition.PartNumber,
+			UHeight:                definition.UHeight,
+			ExcludeFromUtilization: definition.ExcludeFromUtilization,
+			IsFullDepth:            definition.IsFullDepth,
+			SubdeviceRole:          definition.SubdeviceRole,
+			Airflow:                definition.Airflow,
+			Weight:                 definition.Weight,
+			WeightUnit:             definition.WeightUnit,
+			Description:            definition.Description,
+			Comments:               definition.Comments,
+		})
+		if err != nil {
+			return report, fmt.Errorf("error creating device type %q: %w", definition.Model, err)
+		}
+		deviceTypeID = created.ID
+		report.record(true, ObjectTypeDeviceType, deviceTypeID, definition.Model)
+	}
+
+	parent := client.ComponentTemplateInput{DeviceType: deviceTypeID}
+	filter := &client.ListComponentTemplatesInput{DeviceTypeID: []int{deviceTypeID}}
+
+	return report, i.importComponents(ctx, parent, filter, &definition.Components, report)
+}
+
+// ImportModuleType creates a module type, its manufacturer and its component
+// templates, skipping any which already exist
+func (i *Importer) ImportModuleType(ctx context.Context, definition *ModuleType) (*Report, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+
+	manufacturerID, err := i.ensureManufacturer(ctx, definition.Manufacturer, report)
+	if err != nil {
+		return report, err
+	}
+
+	existing, err := i.client.ModuleTypes().List(ctx, &client.ListModuleTypesInput{
+		ManufacturerID: []int{manufacturerID},
+		Model:          []string{definition.Model},
+	})
+	if err != nil {
+		return report, fmt.Errorf("error looking up module type %q: %w", definition.Model, err)
+	}
+
+	var moduleTypeID int
+	if len(existing) > 0 {
+		moduleTypeID = existing[0].ID
+		report.record(false, ObjectTypeModuleType, moduleTypeID, definition.Model)
+	} else {
+		created, err := i.client.ModuleTypes().Create(ctx, &client.CreateModuleTypeInput{
+			Manufacturer: manufacturerID,
+			Model:        definition.Model,
+			PartNumber:   definition.PartNumber,
+			Airflow:      definition.Airflow,
+			Weight:       definition.Weight,
+			WeightUnit:   definition.WeightUnit,
+			Description:  definition.Description,
+			Comments:     definition.Comments,
+		})
+		if err != nil {
+			return report, fmt.Errorf("error creating module type %q: %w", definition.Model, err)
+		}
+		moduleTypeID = created.ID
+		report.record(true, ObjectTypeModuleType, moduleTypeID, definition.Model)
+	}
+
+	parent := client.ComponentTemplateInput{ModuleType: moduleTypeID}
+	filter := &client.ListComponentTemplatesInput{ModuleTypeID: []int{moduleTypeID}}
+
+	return report, i.importComponents(ctx, parent, filter, &definition.Components, report)
+}
+
+// findDeviceType looks up a manufacturer's device type by model, falling back
+// to its slug. It returns nil if neither matches.
+func (i *Importer) findDeviceType(ctx context.Context, manufacturerID int, model, slug string) (*client.DeviceType, error) {
+	filters := []*client.ListDeviceTypesInput{
+		{ManufacturerID: []int{manufacturerID}, Model: []string{model}},
+		{ManufacturerID: []int{manufacturerID}, Slug: []string{slug}},
+	}
+
+	for _, filter := range filters {
+		existing, err := i.client.DeviceTypes().List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up device type %q: %w", model, err)
+		}
+
+		if len(existing) > 0 {
+			return &existing[0], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ensureManufacturer looks up a manufacturer by name, falling back to the slug
+// of its name, and creates it if neither matches
+func (i *Importer) ensureManufacturer(ctx context.Context, name string, report *Report) (int, error) {
+	slug := slugify(name)
+
+	byName, err := i.client.Manufacturers().List(ctx, &client.ListManufacturersInput{Name: name})
+	if err != nil {
+		return 0, fmt.Errorf("error looking up manufacturer %q: %w", name, err)
+	}
+
+	// The name filter is a partial match, so pick out the manufacturer whose
+	// name matches exactly. Netbox compares manufacturer names case-insensitively.
+	for _, manufacturer := range byName {
+		if strings.EqualFold(manufacturer.Name, name) {
+			report.record(false, ObjectTypeManufacturer, manufacturer.ID, name)
+			return manufacturer.ID, nil
+		}
+	}
+
+	bySlug, err := i.client.Manufacturers().List(ctx, &client.ListManufacturersInput{Slug: []string{slug}})
+	if err != nil {
+		return 0, fmt.Errorf("error looking up manufacturer %q: %w", name, err)
+	}
+
+	if len(bySlug) > 0 {
+		report.record(false, ObjectTypeManufacturer, bySlug[0].ID, name)
+		return bySlug[0].ID, nil
+	}
+
+	created, err := i.client.Manufacturers().Create(ctx, &client.CreateManufacturerInput{Name: name, Slug: slug})
+	if err != nil {
+		return 0, fmt.Errorf("error creating manufacturer %q: %w", name, err)
+	}
+
+	report.record(true, ObjectTypeManufacturer, created.ID, name)
+
+	return created.ID, nil
+}
+
+// importComponents creates the component templates of a device or module
+// type. Power ports and rear ports are created before the power outlets and
+// front ports which refer to them.
+func (i *Importer) importComponents(ctx context.Context, parent client.ComponentTemplateInput, filter *client.ListComponentTemplatesInput, components *Components, report *Report) error {
+	base := func(name, label, description string) client.ComponentTemplateInput {
+		input := parent
+		input.Name = name
+		input.Label = label
+		input.Description = description
+		return input
+	}
+
+	interfaces := make([]*client.CreateInterfaceTemplateInput, 0, len(components.Interfaces))
+	for _, c := range components.Interfaces {
+		interfaces = append(interfaces, &client.CreateInterfaceTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+			Enabled:                c.Enabled,
+			MgmtOnly:               c.MgmtOnly,
+			PoEMode:                c.PoEMode,
+			PoEType:                c.PoEType,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.InterfaceTemplates(), filter, ObjectTypeInterfaceTemplate,
+		func(t *client.InterfaceTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateInterfaceTemplateInput) string { return c.Name },
+		interfaces, report); err != nil {
+		return err
+	}
+
+	consolePorts := make([]*client.CreateConsolePortTemplateInput, 0, len(components.ConsolePorts))
+	for _, c := range components.ConsolePorts {
+		consolePorts = append(consolePorts, &client.CreateConsolePortTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.ConsolePortTemplates(), filter, ObjectTypeConsolePortTemplate,
+		func(t *client.ConsolePortTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateConsolePortTemplateInput) string { return c.Name },
+		consolePorts, report); err != nil {
+		return err
+	}
+
+	consoleServerPorts := make([]*client.CreateConsoleServerPortTemplateInput, 0, len(components.ConsoleServerPorts))
+	for _, c := range components.ConsoleServerPorts {
+		consoleServerPorts = append(consoleServerPorts, &client.CreateConsoleServerPortTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.ConsoleServerPortTemplates(), filter, ObjectTypeConsoleServerPortTemplate,
+		func(t *client.ConsoleServerPortTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateConsoleServerPortTemplateInput) string { return c.Name },
+		consoleServerPorts, report); err != nil {
+		return err
+	}
+
+	powerPorts := make([]*client.CreatePowerPortTemplateInput, 0, len(components.PowerPorts))
+	for _, c := range components.PowerPorts {
+		powerPorts = append(powerPorts, &client.CreatePowerPortTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+			MaximumDraw:            c.MaximumDraw,
+			AllocatedDraw:          c.AllocatedDraw,
+		})
+	}
+	powerPortIDs, err := ensureTemplates(ctx, i.client.PowerPortTemplates(), filter, ObjectTypePowerPortTemplate,
+		func(t *client.PowerPortTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreatePowerPortTemplateInput) string { return c.Name },
+		powerPorts, report)
+	if err != nil {
+		return err
+	}
+
+	powerOutlets := make([]*client.CreatePowerOutletTemplateInput, 0, len(components.PowerOutlets))
+	for _, c := range components.PowerOutlets {
+		powerOutlets = append(powerOutlets, &client.CreatePowerOutletTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+			PowerPort:              powerPortIDs[c.PowerPort],
+			FeedLeg:                c.FeedLeg,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.PowerOutletTemplates(), filter, ObjectTypePowerOutletTemplate,
+		func(t *client.PowerOutletTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreatePowerOutletTemplateInput) string { return c.Name },
+		powerOutlets, report); err != nil {
+		return err
+	}
+
+	rearPorts := make([]*client.CreateRearPortTemplateInput, 0, len(components.RearPorts))
+	for _, c := range components.RearPorts {
+		rearPorts = append(rearPorts, &client.CreateRearPortTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+			Color:                  c.Color,
+			Positions:              c.Positions,
+		})
+	}
+	rearPortIDs, err := ensureTemplates(ctx, i.client.RearPortTemplates(), filter, ObjectTypeRearPortTemplate,
+		func(t *client.RearPortTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateRearPortTemplateInput) string { return c.Name },
+		rearPorts, report)
+	if err != nil {
+		return err
+	}
+
+	frontPorts := make([]*client.CreateFrontPortTemplateInput, 0, len(components.FrontPorts))
+	for _, c := range components.FrontPorts {
+		frontPorts = append(frontPorts, &client.CreateFrontPortTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Type:                   c.Type,
+			Color:                  c.Color,
+			RearPort:               rearPortIDs[c.RearPort],
+			RearPortPosition:       c.RearPortPosition,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.FrontPortTemplates(), filter, ObjectTypeFrontPortTemplate,
+		func(t *client.FrontPortTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateFrontPortTemplateInput) string { return c.Name },
+		frontPorts, report); err != nil {
+		return err
+	}
+
+	moduleBays := make([]*client.CreateModuleBayTemplateInput, 0, len(components.ModuleBays))
+	for _, c := range components.ModuleBays {
+		moduleBays = append(moduleBays, &client.CreateModuleBayTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+			Position:               c.Position,
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.ModuleBayTemplates(), filter, ObjectTypeModuleBayTemplate,
+		func(t *client.ModuleBayTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateModuleBayTemplateInput) string { return c.Name },
+		moduleBays, report); err != nil {
+		return err
+	}
+
+	deviceBays := make([]*client.CreateDeviceBayTemplateInput, 0, len(components.DeviceBays))
+	for _, c := range components.DeviceBays {
+		deviceBays = append(deviceBays, &client.CreateDeviceBayTemplateInput{
+			ComponentTemplateInput: base(c.Name, c.Label, c.Description),
+		})
+	}
+	if _, err := ensureTemplates(ctx, i.client.DeviceBayTemplates(), filter, ObjectTypeDeviceBayTemplate,
+		func(t *client.DeviceBayTemplate) *client.ComponentTemplate { return &t.ComponentTemplate },
+		func(c *client.CreateDeviceBayTemplateInput) string { return c.Name },
+		deviceBays, report); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ensureTemplates creates each template in inputs which its parent does not
+// already have, matching by name. It returns the IDs of all of the parent's
+// templates of this kind, keyed by name.
+func ensureTemplates[T, C, U, P any](
+	ctx context.Context,
+	resource *client.Resource[T, C, U, P, client.ListComponentTemplatesInput],
+	filter *client.ListComponentTemplatesInput,
+	objectType string,
+	base func(*T) *client.ComponentTemplate,
+	name func(*C) string,
+	inputs []*C,
+	report *Report,
+) (map[string]int, error) {
+	ids := make(map[string]int)
+
+	if len(inputs) == 0 {
+		return ids, nil
+	}
+
+	existing, err := resource.ListAll(ctx, filter, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", objectType, err)
+	}
+
+	for idx := range existing {
+		template := base(&existing[idx])
+		ids[template.Name] = template.ID
+	}
+
+	for _, input := range inputs {
+		if id, ok := ids[name(input)]; ok {
+			report.record(false, objectType, id, name(input))
+			continue
+		}
+
+		created, err := resource.Create(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s %q: %w", objectType, name(input), err)
+		}
+
+		template := base(created)
+		ids[template.Name] = template.ID
+		report.record(true, objectType, template.ID, template.Name)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,279 @@
+package devicetypes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/client"
+	"github.com/zeddD1abl0/go-netbox-client/models"
+)
+
+const switchYAML = `
+manufacturer: Acme Networks
+model: AS-48
+slug: acme-as-48
+part_number: AS-48-AC
+u_height: 1
+is_full_depth: false
+airflow: front-to-rear
+interfaces:
+  - name: eth1
+    type: 1000base-t
+  - name: mgmt0
+    type: 1000base-t
+    mgmt_only: true
+console-ports:
+  - name: con0
+    type: rj-45
+console-server-ports:
+  - name: ttyS1
+    type: rj-45
+power-ports:
+  - name: PSU1
+    type: iec-60320-c14
+    maximum_draw: 150
+power-outlets:
+  - name: out1
+    type: iec-60320-c13
+    power_port: PSU1
+    feed_leg: A
+rear-ports:
+  - name: rear1
+    type: mpo
+    positions: 4
+front-ports:
+  - name: front1
+    type: lc
+    rear_port: rear1
+    rear_port_position: 1
+module-bays:
+  - name: slot1
+    position: "1"
+device-bays:
+  - name: bay1
+`
+
+// fakeNetbox is a minimal in-memory Netbox which supports the list and create
+// calls made by the importer
+type fakeNetbox struct {
+	mu      sync.Mutex
+	nextID  int
+	objects map[string][]map[string]any
+}
+
+func newFakeNetbox(t *testing.T) *client.Client {
+	fake := &fakeNetbox{objects: make(map[string][]map[string]any)}
+	ts := httptest.NewServer(fake)
+	t.Cleanup(ts.Close)
+
+	c, err := client.NewClient(ts.URL, "test-token")
+	require.NoError(t, err)
+
+	return c
+}
+
+// nestedFields are stored as IDs but returned as nested objects
+var nestedFields = map[string]string{
+	"manufacturer_id": "manufacturer",
+	"device_type_id":  "device_type",
+	"module_type_id":  "module_type",
+	"rear_port_id":    "rear_port",
+}
+
+func (f *fakeNetbox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	endpoint := strings.TrimPrefix(r.URL.Path, "/api/dcim/")
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method {
+	case http.MethodPost:
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.nextID++
+		body["id"] = float64(f.nextID)
+		f.objects[endpoint] = append(f.objects[endpoint], body)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(render(body))
+	case http.MethodGet:
+		results := make([]map[string]any, 0)
+		for _, object := range f.objects[endpoint] {
+			if matches(object, r) {
+				results = append(results, render(object))
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": len(results), "results": results})
+	}
+}
+
+// matches applies the query filters used by the importer to a stored object
+func matches(object map[string]any, r *http.Request) bool {
+	for key, values := range r.URL.Query() {
+		field := key
+		if nested, ok := nestedFields[key]; ok {
+			field = nested
+		}
+		if key == "limit" || key == "offset" {
+			continue
+		}
+
+		partial := strings.HasSuffix(key, "__ic")
+		if partial {
+			field = strings.TrimSuffix(key, "__ic")
+		}
+
+		var actual string
+		switch v := object[field].(type) {
+		case float64:
+			actual = strconv.Itoa(int(v))
+		case string:
+			actual = v
+		}
+
+		if partial {
+			if !strings.Contains(strings.ToLower(actual), strings.ToLower(values[0])) {
+				return false
+			}
+		} else if actual != values[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// render converts a stored object to its API representation
+func render(object map[string]any) map[string]any {
+	out := make(map[string]any, len(object))
+	for key, value := range object {
+		switch key {
+		case "manufacturer", "device_type", "module_type", "rear_port", "power_port":
+			out[key] = map[string]any{"id": value}
+		case "type", "airflow", "subdevice_role", "feed_leg":
+			out[key] = map[string]any{"value": value}
+		default:
+			out[key] = value
+		}
+	}
+
+	return out
+}
+
+func TestParseDeviceType(t *testing.T) {
+	deviceType, err := ParseDeviceType([]byte(switchYAML))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme Networks", deviceType.Manufacturer)
+	assert.Equal(t, "AS-48", deviceType.Model)
+	assert.Equal(t, 1.0, *deviceType.UHeight)
+	assert.False(t, *deviceType.IsFullDepth)
+	require.Len(t, deviceType.Interfaces, 2)
+	assert.True(t, deviceType.Interfaces[1].MgmtOnly)
+	assert.Equal(t, 150, *deviceType.PowerPorts[0].MaximumDraw)
+	assert.Equal(t, "rear1", deviceType.FrontPorts[0].RearPort)
+	assert.Equal(t, "1", deviceType.ModuleBays[0].Position)
+}
+
+func TestParseDeviceTypeInvalid(t *testing.T) {
+	_, err := ParseDeviceType([]byte(`
+model: AS-48
+interfaces:
+  - name: eth1
+    type: 1000base-t
+  - name: eth1
+    type: 1000base-t
+front-ports:
+  - name: front1
+    type: lc
+    rear_port: missing
+`))
+	require.Error(t, err)
+
+	var errs models.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 3)
+}
+
+func TestImportDeviceTypeIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	netbox := newFakeNetbox(t)
+	importer := NewImporter(netbox)
+
+	deviceType, err := ParseDeviceType([]byte(switchYAML))
+	require.NoError(t, err)
+
+	report, err := importer.ImportDeviceType(ctx, deviceType)
+	require.NoError(t, err)
+	assert.Len(t, report.Created, 12)
+	assert.Empty(t, report.Existing)
+	assert.Equal(t, ObjectTypeManufacturer, report.Created[0].Type)
+	assert.Equal(t, ObjectTypeDeviceType, report.Created[1].Type)
+
+	outlets, err := netbox.PowerOutletTemplates().List(ctx, &client.ListComponentTemplatesInput{DeviceTypeID: []int{report.Created[1].ID}})
+	require.NoError(t, err)
+	require.Len(t, outlets, 1)
+	require.NotNil(t, outlets[0].PowerPort)
+	assert.NotZero(t, outlets[0].PowerPort.ID)
+
+	report, err = importer.ImportDeviceType(ctx, deviceType)
+	require.NoError(t, err)
+	assert.Empty(t, report.Created)
+	assert.Len(t, report.Existing, 12)
+
+	deviceType.Interfaces = append(deviceType.Interfaces, Interface{Name: "eth2", Type: "1000base-t"})
+	report, err = importer.ImportDeviceType(ctx, deviceType)
+	require.NoError(t, err)
+	require.Len(t, report.Created, 1)
+	assert.Equal(t, Object{Type: ObjectTypeInterfaceTemplate, ID: report.Created[0].ID, Name: "eth2"}, report.Created[0])
+}
+
+func TestImportDeviceTypeMatchesExistingByNameAndSlug(t *testing.T) {
+	ctx := context.Background()
+	netbox := newFakeNetbox(t)
+	importer := NewImporter(netbox)
+
+	// The manufacturer's slug and the device type's model differ from what
+	// the definition would generate, so they can only be found by name and slug
+	manufacturer, err := netbox.Manufacturers().Create(ctx, &client.CreateManufacturerInput{Name: "Acme Networks", Slug: "acme"})
+	require.NoError(t, err)
+	existing, err := netbox.DeviceTypes().Create(ctx, &client.CreateDeviceTypeInput{Manufacturer: manufacturer.ID, Model: "AS-48 (rev A)", Slug: "acme-as-48"})
+	require.NoError(t, err)
+
+	report, err := importer.ImportDeviceType(ctx, &DeviceType{Manufacturer: "Acme Networks", Model: "AS-48", Slug: "acme-as-48"})
+	require.NoError(t, err)
+	assert.Empty(t, report.Created)
+	assert.Equal(t, []Object{
+		{Type: ObjectTypeManufacturer, ID: manufacturer.ID, Name: "Acme Networks"},
+		{Type: ObjectTypeDeviceType, ID: existing.ID, Name: "AS-48"},
+	}, report.Existing)
+}
+
+func TestImportModuleType(t *testing.T) {
+	ctx := context.Background()
+	importer := NewImporter(newFakeNetbox(t))
+
+	moduleType, err := ParseModuleType([]byte(`
+manufacturer: Acme Networks
+model: LC-8
+interfaces:
+  - name: eth{module}/1
+    type: 10gbase-x-sfpp
+`))
+	require.NoError(t, err)
+
+	report, err := importer.ImportModuleType(ctx, moduleType)
+	require.NoError(t, err)
+	require.Len(t, report.Created, 3)
+	assert.Equal(t, ObjectTypeModuleType, report.Created[1].Type)
+	assert.Equal(t, "eth{module}/1", report.Created[2].Name)
+}
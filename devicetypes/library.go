@@ -0,0 +1,303 @@
+// Package devicetypes imports hardware models described in the community
+// devicetype-library YAML format (https://github.com/netbox-community/devicetype-library)
+// into Netbox as device types, module types and their component templates.
+package devicetypes
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/zeddD1abl0/go-netbox-client/models"
+	"gopkg.in/yaml.v3"
+)
+
+// DeviceType is a device type definition from the devicetype-library
+type DeviceType struct {
+	Manufacturer           string   `yaml:"manufacturer"`
+	Model                  string   `yaml:"model"`
+	Slug                   string   `yaml:"slug"`
+	PartNumber             string   `yaml:"part_number"`
+	UHeight                *float64 `yaml:"u_height"`
+	IsFullDepth            *bool    `yaml:"is_full_depth"`
+	ExcludeFromUtilization bool     `yaml:"exclude_from_utilization"`
+	SubdeviceRole          string   `yaml:"subdevice_role"`
+	Airflow                string   `yaml:"airflow"`
+	Weight                 *float64 `yaml:"weight"`
+	WeightUnit             string   `yaml:"weight_unit"`
+	Description            string   `yaml:"description"`
+	Comments               string   `yaml:"comments"`
+	Components             `yaml:",inline"`
+}
+
+// ModuleType is a module type definition from the devicetype-library
+type ModuleType struct {
+	Manufacturer string   `yaml:"manufacturer"`
+	Model        string   `yaml:"model"`
+	PartNumber   string   `yaml:"part_number"`
+	Airflow      string   `yaml:"airflow"`
+	Weight       *float64 `yaml:"weight"`
+	WeightUnit   string   `yaml:"weight_unit"`
+	Description  string   `yaml:"description"`
+	Comments     string   `yaml:"comments"`
+	Components   `yaml:",inline"`
+}
+
+// Components lists the component templates of a device or module type
+type Components struct {
+	Interfaces         []Interface         `yaml:"interfaces"`
+	ConsolePorts       []ConsolePort       `yaml:"console-ports"`
+	ConsoleServerPorts []ConsoleServerPort `yaml:"console-server-ports"`
+	PowerPorts         []PowerPort         `yaml:"power-ports"`
+	PowerOutlets       []PowerOutlet       `yaml:"power-outlets"`
+	FrontPorts         []FrontPort         `yaml:"front-ports"`
+	RearPorts          []RearPort          `yaml:"rear-ports"`
+	ModuleBays         []ModuleBay         `yaml:"module-bays"`
+	DeviceBays         []DeviceBay         `yaml:"device-bays"`
+}
+
+// Interface is an interface template definition
+type Interface struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Enabled     *bool  `yaml:"enabled"`
+	MgmtOnly    bool   `yaml:"mgmt_only"`
+	PoEMode     string `yaml:"poe_mode"`
+	PoEType     string `yaml:"poe_type"`
+	Description string `yaml:"description"`
+}
+
+// ConsolePort is a console port template definition
+type ConsolePort struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+}
+
+// ConsoleServerPort is a console server port template definition
+type ConsoleServerPort struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+}
+
+// PowerPort is a power port template definition
+type PowerPort struct {
+	Name          string `yaml:"name"`
+	Label         string `yaml:"label"`
+	Type          string `yaml:"type"`
+	MaximumDraw   *int   `yaml:"maximum_draw"`
+	AllocatedDraw *int   `yaml:"allocated_draw"`
+	Description   string `yaml:"description"`
+}
+
+// PowerOutlet is a power outlet template definition. PowerPort optionally
+// names the power port defined by the same device or module type which feeds
+// the outlet.
+type PowerOutlet struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	PowerPort   string `yaml:"power_port"`
+	FeedLeg     string `yaml:"feed_leg"`
+	Description string `yaml:"description"`
+}
+
+// FrontPort is a front port template definition. RearPort names a rear port
+// defined by the same device or module type.
+type FrontPort struct {
+	Name             string `yaml:"name"`
+	Label            string `yaml:"label"`
+	Type             string `yaml:"type"`
+	Color            string `yaml:"color"`
+	RearPort         string `yaml:"rear_port"`
+	RearPortPosition int    `yaml:"rear_port_position"`
+	Description      string `yaml:"description"`
+}
+
+// RearPort is a rear port template definition
+type RearPort struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Type        string `yaml:"type"`
+	Color       string `yaml:"color"`
+	Positions   int    `yaml:"positions"`
+	Description string `yaml:"description"`
+}
+
+// ModuleBay is a module bay template definition
+type ModuleBay struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Position    string `yaml:"position"`
+	Description string `yaml:"description"`
+}
+
+// DeviceBay is a device bay template definition. Device bays are only
+// supported on device types.
+type DeviceBay struct {
+	Name        string `yaml:"name"`
+	Label       string `yaml:"label"`
+	Description string `yaml:"description"`
+}
+
+// ParseDeviceType parses a device type definition from YAML
+func ParseDeviceType(data []byte) (*DeviceType, error) {
+	var deviceType DeviceType
+	if err := yaml.Unmarshal(data, &deviceType); err != nil {
+		return nil, fmt.Errorf("error parsing device type: %w", err)
+	}
+
+	if err := deviceType.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &deviceType, nil
+}
+
+// ParseDeviceTypeFile reads and parses a device type definition from a YAML file
+func ParseDeviceTypeFile(path string) (*DeviceType, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading device type: %w", err)
+	}
+
+	return ParseDeviceType(data)
+}
+
+// ParseModuleType parses a module type definition from YAML
+func ParseModuleType(data []byte) (*ModuleType, error) {
+	var moduleType ModuleType
+	if err := yaml.Unmarshal(data, &moduleType); err != nil {
+		return nil, fmt.Errorf("error parsing module type: %w", err)
+	}
+
+	if err := moduleType.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &moduleType, nil
+}
+
+// ParseModuleTypeFile reads and parses a module type definition from a YAML file
+func ParseModuleTypeFile(path string) (*ModuleType, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading module type: %w", err)
+	}
+
+	return ParseModuleType(data)
+}
+
+// Validate validates the DeviceType definition
+func (d *DeviceType) Validate() error {
+	errors := validateDefinition(d.Manufacturer, d.Model, &d.Components)
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// Validate validates the ModuleType definition
+func (m *ModuleType) Validate() error {
+	errors := validateDefinition(m.Manufacturer, m.Model, &m.Components)
+
+	if len(m.DeviceBays) > 0 {
+		errors = append(errors, models.ValidationError{
+			Field:   "device-bays",
+			Message: "device bays are not supported on module types",
+		})
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	return nil
+}
+
+// validateDefinition checks the fields shared by device and module type
+// definitions. Component names must be set and unique within their kind, each
+// front port must map onto a rear port and each power outlet may only refer to
+// a power port in the same definition.
+func validateDefinition(manufacturer, model string, components *Components) models.ValidationErrors {
+	var errors models.ValidationErrors
+
+	if err := models.ValidateRequired("manufacturer", manufacturer); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	if err := models.ValidateRequired("model", model); err != nil {
+		errors = append(errors, *err.(*models.ValidationError))
+	}
+
+	checkNames := func(section string, count int, name func(i int) string) {
+		seen := make(map[string]bool, count)
+		for i := 0; i < count; i++ {
+			field := fmt.Sprintf("%s.%d.name", section, i)
+			if err := models.ValidateRequired(field, name(i)); err != nil {
+				errors = append(errors, *err.(*models.ValidationError))
+			} else if seen[name(i)] {
+				errors = append(errors, models.ValidationError{
+					Field:   field,
+					Message: fmt.Sprintf("duplicate name %q", name(i)),
+				})
+			}
+			seen[name(i)] = true
+		}
+	}
+
+	checkNames("interfaces", len(components.Interfaces), func(i int) string { return components.Interfaces[i].Name })
+	checkNames("console-ports", len(components.ConsolePorts), func(i int) string { return components.ConsolePorts[i].Name })
+	checkNames("console-server-ports", len(components.ConsoleServerPorts), func(i int) string { return components.ConsoleServerPorts[i].Name })
+	checkNames("power-ports", len(components.PowerPorts), func(i int) string { return components.PowerPorts[i].Name })
+	checkNames("power-outlets", len(components.PowerOutlets), func(i int) string { return components.PowerOutlets[i].Name })
+	checkNames("rear-ports", len(components.RearPorts), func(i int) string { return components.RearPorts[i].Name })
+	checkNames("front-ports", len(components.FrontPorts), func(i int) string { return components.FrontPorts[i].Name })
+	checkNames("module-bays", len(components.ModuleBays), func(i int) string { return components.ModuleBays[i].Name })
+	checkNames("device-bays", len(components.DeviceBays), func(i int) string { return components.DeviceBays[i].Name })
+
+	powerPorts := make(map[string]bool, len(components.PowerPorts))
+	for _, port := range components.PowerPorts {
+		powerPorts[port.Name] = true
+	}
+
+	for i, outlet := range components.PowerOutlets {
+		if outlet.PowerPort != "" && !powerPorts[outlet.PowerPort] {
+			errors = append(errors, models.ValidationError{
+				Field:   fmt.Sprintf("power-outlets.%d.power_port", i),
+				Message: fmt.Sprintf("unknown power port %q", outlet.PowerPort),
+			})
+		}
+	}
+
+	rearPorts := make(map[string]bool, len(components.RearPorts))
+	for _, port := range components.RearPorts {
+		rearPorts[port.Name] = true
+	}
+
+	for i, port := range components.FrontPorts {
+		if !rearPorts[port.RearPort] {
+			errors = append(errors, models.ValidationError{
+				Field:   fmt.Sprintf("front-ports.%d.rear_port", i),
+				Message: fmt.Sprintf("unknown rear port %q", port.RearPort),
+			})
+		}
+	}
+
+	return errors
+}
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// slugify derives a Netbox slug from a name the same way the Netbox UI does:
+// lowercased, with runs of other characters replaced by a hyphen
+func slugify(name string) string {
+	return strings.Trim(slugInvalidChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
@@ -5,12 +5,11 @@ go 1.21
 require (
 	github.com/go-resty/resty/v2 v2.10.0
 	github.com/stretchr/testify v1.8.4
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/go-ozzo/ozzo-validation/v4 v4.3.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
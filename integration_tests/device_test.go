@@ -0,0 +1,86 @@
+package integration_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/client"
+)
+
+func TestDeviceIntegration(t *testing.T) {
+	c := setupTestClient(t)
+	ctx := context.Background()
+	cleanup := newCleanupList(t)
+	defer cleanup.runAll()
+
+	t.Run("CRUD operations", func(t *testing.T) {
+		fixture := createDeviceFixture(t, c, cleanup, "device-test")
+
+		// Create devices
+		names := []string{"device-test-1", "device-test-2"}
+		var createdDevices []*client.Device
+		for _, name := range names {
+			device, err := c.Devices().Create(ctx, &client.CreateDeviceInput{
+				Name:       name,
+				DeviceType: fixture.DeviceType.ID,
+				Role:       fixture.Role.ID,
+				Site:       fixture.Site.ID,
+				Status:     client.DeviceStatusPlanned,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, device)
+			assert.Equal(t, name, device.Name)
+			assert.Equal(t, client.DeviceStatusPlanned, device.Status.Value)
+			assert.Equal(t, fixture.Site.ID, device.Site.ID)
+
+			createdDevices = append(createdDevices, device)
+			cleanup.add(func() error {
+				return c.Devices().Delete(ctx, device.ID)
+			})
+		}
+
+		// List devices by site
+		devices, err := c.Devices().List(ctx, &client.ListDevicesInput{SiteID: []int{fixture.Site.ID}})
+		require.NoError(t, err)
+		assert.Len(t, devices, len(names))
+
+		// Get device
+		device, err := c.Devices().Get(ctx, createdDevices[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, createdDevices[0].Name, device.Name)
+		assert.Equal(t, fixture.DeviceType.ID, device.DeviceType.ID)
+		assert.Equal(t, fixture.Role.ID, device.Role.ID)
+
+		// Update device
+		updated, err := c.Devices().Update(ctx, device.ID, &client.UpdateDeviceInput{
+			Name:        device.Name,
+			DeviceType:  fixture.DeviceType.ID,
+			Role:        fixture.Role.ID,
+			Site:        fixture.Site.ID,
+			Status:      client.DeviceStatusActive,
+			Description: "Updated device",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, client.DeviceStatusActive, updated.Status.Value)
+		assert.Equal(t, "Updated device", updated.Description)
+
+		// Patch device
+		patched, err := c.Devices().Patch(ctx, device.ID, &client.PatchDeviceInput{
+			Serial: strPtr("SN-0001"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "SN-0001", patched.Serial)
+		assert.Equal(t, "Updated device", patched.Description)
+
+		// Filter by status
+		active, err := c.Devices().List(ctx, &client.ListDevicesInput{
+			SiteID: []int{fixture.Site.ID},
+			Status: []string{client.DeviceStatusActive},
+		})
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		assert.Equal(t, device.ID, active[0].ID)
+	})
+}
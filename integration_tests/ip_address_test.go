@@ -0,0 +1,72 @@
+package integration_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/client"
+)
+
+func TestIPAddressIntegration(t *testing.T) {
+	c := setupTestClient(t)
+	ctx := context.Background()
+	cleanup := newCleanupList(t)
+	defer cleanup.runAll()
+
+	t.Run("Primary IP assignment", func(t *testing.T) {
+		fixture := createDeviceFixture(t, c, cleanup, "ip-test")
+
+		device, err := c.Devices().Create(ctx, &client.CreateDeviceInput{
+			Name:       "ip-test-device",
+			DeviceType: fixture.DeviceType.ID,
+			Role:       fixture.Role.ID,
+			Site:       fixture.Site.ID,
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.Devices().Delete(ctx, device.ID)
+		})
+
+		iface, err := c.Interfaces().Create(ctx, &client.CreateInterfaceInput{
+			Device: device.ID,
+			Name:   "mgmt0",
+			Type:   client.InterfaceType1000BaseT,
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.Interfaces().Delete(ctx, iface.ID)
+		})
+
+		address, err := c.IPAddresses().Create(ctx, &client.CreateIPAddressInput{
+			Address: "192.0.2.10/24",
+			Status:  client.IPAddressStatusActive,
+			DNSName: "ip-test-device.example.com",
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.IPAddresses().Delete(ctx, address.ID)
+		})
+		assert.Equal(t, "192.0.2.10/24", address.Address)
+		assert.Equal(t, 4, address.Family.Value)
+
+		// Assign the address to the interface and make it the primary IP
+		updated, err := c.AssignPrimaryIP(address.ID, iface.ID)
+		require.NoError(t, err)
+		require.NotNil(t, updated.PrimaryIP4)
+		assert.Equal(t, address.ID, updated.PrimaryIP4.ID)
+
+		assigned, err := c.IPAddresses().Get(ctx, address.ID)
+		require.NoError(t, err)
+		assert.Equal(t, client.AssignedObjectTypeInterface, assigned.AssignedObjectType)
+		require.NotNil(t, assigned.AssignedObjectID)
+		assert.Equal(t, iface.ID, *assigned.AssignedObjectID)
+
+		// List addresses assigned to the device
+		addresses, err := c.IPAddresses().List(ctx, &client.ListIPAddressesInput{DeviceID: []int{device.ID}})
+		require.NoError(t, err)
+		require.Len(t, addresses, 1)
+		assert.Equal(t, address.ID, addresses[0].ID)
+	})
+}
@@ -0,0 +1,85 @@
+package integration_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/client"
+)
+
+func TestPrefixIntegration(t *testing.T) {
+	c := setupTestClient(t)
+	ctx := context.Background()
+	cleanup := newCleanupList(t)
+	defer cleanup.runAll()
+
+	t.Run("Available prefix and IP allocation", func(t *testing.T) {
+		container, err := c.Prefixes().Create(ctx, &client.CreatePrefixInput{
+			Prefix:      "10.250.0.0/22",
+			Status:      client.PrefixStatusContainer,
+			Description: "Prefix test container",
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.Prefixes().Delete(ctx, container.ID)
+		})
+		assert.Equal(t, client.PrefixStatusContainer, container.Status.Value)
+
+		available, err := c.ListAvailablePrefixes(container.ID)
+		require.NoError(t, err)
+		require.Len(t, available, 1)
+		assert.Equal(t, "10.250.0.0/22", available[0].Prefix)
+
+		// Allocate a child prefix
+		child, err := c.AllocatePrefix(container.ID, &client.AllocatePrefixInput{
+			PrefixLength: 24,
+			Status:       client.PrefixStatusActive,
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.Prefixes().Delete(ctx, child.ID)
+		})
+		assert.Equal(t, "10.250.0.0/24", child.Prefix)
+
+		// List prefixes within the container
+		prefixes, err := c.Prefixes().List(ctx, &client.ListPrefixesInput{Within: container.Prefix})
+		require.NoError(t, err)
+		require.Len(t, prefixes, 1)
+		assert.Equal(t, child.ID, prefixes[0].ID)
+
+		// Allocate addresses from the child prefix
+		ips, err := c.ListAvailableIPsInPrefix(child.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, ips, 2)
+		assert.Equal(t, "10.250.0.1/24", ips[0].Address)
+
+		allocated, err := c.AllocateIPsFromPrefix(child.ID, 2, &client.AllocateIPInput{
+			Status:      client.IPAddressStatusActive,
+			Description: "Prefix test address",
+		})
+		require.NoError(t, err)
+		for _, ip := range allocated {
+			id := ip.ID
+			cleanup.add(func() error {
+				return c.IPAddresses().Delete(ctx, id)
+			})
+		}
+		require.Len(t, allocated, 2)
+		assert.Equal(t, "10.250.0.1/24", allocated[0].Address)
+		assert.Equal(t, "10.250.0.2/24", allocated[1].Address)
+
+		ips, err = c.ListAvailableIPsInPrefix(child.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, ips, 1)
+		assert.Equal(t, "10.250.0.3/24", ips[0].Address)
+
+		// Patch the child prefix
+		patched, err := c.Prefixes().Patch(ctx, child.ID, &client.PatchPrefixInput{
+			Description: strPtr("Allocated prefix"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Allocated prefix", patched.Description)
+	})
+}
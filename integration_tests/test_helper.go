@@ -1,6 +1,7 @@
 package integration_tests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -52,3 +53,58 @@ func (c *cleanupList) runAll() {
 func strPtr(s string) *string {
 	return &s
 }
+
+// deviceFixture holds the objects a device depends on
+type deviceFixture struct {
+	Site       *client.Site
+	DeviceType *client.DeviceType
+	Role       *client.DeviceRole
+}
+
+// createDeviceFixture creates a site, manufacturer, device type and device
+// role to create devices against. The slug prefix keeps fixtures of
+// different tests apart.
+func createDeviceFixture(t *testing.T, c *client.Client, cleanup *cleanupList, prefix string) *deviceFixture {
+	ctx := context.Background()
+
+	site, err := c.CreateSite(&client.CreateSiteInput{
+		Name:   prefix + " Site",
+		Slug:   prefix + "-site",
+		Status: client.SiteStatusActive,
+	})
+	require.NoError(t, err)
+	cleanup.add(func() error {
+		return c.DeleteSite(site.ID)
+	})
+
+	manufacturer, err := c.Manufacturers().Create(ctx, &client.CreateManufacturerInput{
+		Name: prefix + " Manufacturer",
+		Slug: prefix + "-manufacturer",
+	})
+	require.NoError(t, err)
+	cleanup.add(func() error {
+		return c.Manufacturers().Delete(ctx, manufacturer.ID)
+	})
+
+	deviceType, err := c.DeviceTypes().Create(ctx, &client.CreateDeviceTypeInput{
+		Manufacturer: manufacturer.ID,
+		Model:        prefix + " Model",
+		Slug:         prefix + "-model",
+	})
+	require.NoError(t, err)
+	cleanup.add(func() error {
+		return c.DeviceTypes().Delete(ctx, deviceType.ID)
+	})
+
+	role, err := c.DeviceRoles().Create(ctx, &client.CreateDeviceRoleInput{
+		Name:  prefix + " Role",
+		Slug:  prefix + "-role",
+		Color: "2196f3",
+	})
+	require.NoError(t, err)
+	cleanup.add(func() error {
+		return c.DeviceRoles().Delete(ctx, role.ID)
+	})
+
+	return &deviceFixture{Site: site, DeviceType: deviceType, Role: role}
+}
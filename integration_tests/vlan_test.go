@@ -0,0 +1,75 @@
+package integration_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeddD1abl0/go-netbox-client/client"
+)
+
+func TestVLANIntegration(t *testing.T) {
+	c := setupTestClient(t)
+	ctx := context.Background()
+	cleanup := newCleanupList(t)
+	defer cleanup.runAll()
+
+	t.Run("Site scoped VLAN group allocation", func(t *testing.T) {
+		site, err := c.CreateSite(&client.CreateSiteInput{
+			Name:   "VLAN Test Site",
+			Slug:   "vlan-test-site",
+			Status: client.SiteStatusActive,
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.DeleteSite(site.ID)
+		})
+
+		// VID ranges require Netbox 4.1 or later
+		group, err := c.VLANGroups().Create(ctx, &client.CreateVLANGroupInput{
+			Name:      "VLAN Test Group",
+			Slug:      "vlan-test-group",
+			ScopeType: client.VLANGroupScopeSite,
+			ScopeID:   site.ID,
+			VIDRanges: [][2]int{{100, 102}},
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.VLANGroups().Delete(ctx, group.ID)
+		})
+		assert.Equal(t, client.VLANGroupScopeSite, group.ScopeType)
+
+		available, err := c.ListAvailableVLANs(group.ID)
+		require.NoError(t, err)
+		assert.Len(t, available, 3)
+
+		// Allocate the next free VID
+		vlan, err := c.AllocateVLAN(group.ID, &client.AllocateVLANInput{
+			Name:   "servers",
+			Status: client.VLANStatusActive,
+		})
+		require.NoError(t, err)
+		cleanup.add(func() error {
+			return c.VLANs().Delete(ctx, vlan.ID)
+		})
+		assert.Equal(t, 100, vlan.VID)
+
+		// List VLANs in the group
+		vlans, err := c.VLANs().List(ctx, &client.ListVLANsInput{GroupID: []int{group.ID}})
+		require.NoError(t, err)
+		require.Len(t, vlans, 1)
+		assert.Equal(t, "servers", vlans[0].Name)
+
+		// Patch the VLAN
+		patched, err := c.VLANs().Patch(ctx, vlan.ID, &client.PatchVLANInput{
+			Description: strPtr("Server VLAN"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Server VLAN", patched.Description)
+
+		available, err = c.ListAvailableVLANs(group.ID)
+		require.NoError(t, err)
+		assert.Len(t, available, 2)
+	})
+}
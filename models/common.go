@@ -19,11 +19,12 @@ type Tag struct {
 	Description string `json:"description"`
 }
 
-// Tags being created on the fly have no description
+// Tags being created on the fly have no description. Netbox looks tags up by
+// the fields which are set, so a tag can be referenced by its slug alone.
 type TagCreate struct {
-	Name  string `json:"name"`
-	Slug  string `json:"slug"`
-	Color string `json:"color"`
+	Name  string `json:"name,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+	Color string `json:"color,omitempty"`
 }
 
 // CustomField represents a custom field in Netbox
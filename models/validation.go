@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"net/netip"
 	"regexp"
 	"strings"
 )
@@ -38,6 +39,8 @@ type Validator interface {
 
 var slugRegex = regexp.MustCompile(`^[-a-zA-Z0-9_]+$`)
 
+var colorRegex = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
 // ValidateSlug validates a slug string
 func ValidateSlug(slug string) error {
 	if slug == "" {
@@ -65,3 +68,67 @@ func ValidateRequired(field, value string) error {
 	}
 	return nil
 }
+
+// ValidateOneOf validates that a string is one of the allowed values
+func ValidateOneOf(field, value string, allowed ...string) error {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return &ValidationError{
+		Field:   field,
+		Message: "must be one of: " + strings.Join(allowed, ", "),
+	}
+}
+
+// ValidateRange validates that a number is within the given bounds
+func ValidateRange(field string, value, min, max float64) error {
+	if value < min || value > max {
+		return &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("must be between %g and %g", min, max),
+		}
+	}
+	return nil
+}
+
+// ValidatePrefix validates that a string is an IP prefix in CIDR notation
+func ValidatePrefix(field, value string) error {
+	if value == "" {
+		return &ValidationError{
+			Field:   field,
+			Message: "cannot be empty",
+		}
+	}
+	if _, err := netip.ParsePrefix(value); err != nil {
+		return &ValidationError{
+			Field:   field,
+			Message: "must be a valid prefix in CIDR notation",
+		}
+	}
+	return nil
+}
+
+// ValidateColor validates that a string is an RGB color in the six-digit
+// hexadecimal form Netbox uses, without a leading '#'
+func ValidateColor(field, value string) error {
+	if !colorRegex.MatchString(value) {
+		return &ValidationError{
+			Field:   field,
+			Message: "must be a six-digit hexadecimal color, e.g. ff0000",
+		}
+	}
+	return nil
+}
+
+// ValidateMaxLength validates that a string is at most max characters long
+func ValidateMaxLength(field, value string, max int) error {
+	if len([]rune(value)) > max {
+		return &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("must be at most %d characters", max),
+		}
+	}
+	return nil
+}